@@ -0,0 +1,17 @@
+data "discord-application_current_application" "this" {}
+
+# hand the public key to the function verifying interaction signatures
+resource "aws_lambda_function" "interactions" {
+  function_name = "discord-interactions"
+  role          = aws_iam_role.interactions.arn
+  runtime       = "nodejs20.x"
+  handler       = "index.handler"
+  filename      = "interactions.zip"
+
+  environment {
+    variables = {
+      DISCORD_APPLICATION_ID = data.discord-application_current_application.this.id
+      DISCORD_PUBLIC_KEY     = data.discord-application_current_application.this.verify_key
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "discord-application_emojis" "all" {
+  application_id = "9876543210123456789"
+}
+
+output "welcome_message" {
+  value = "Welcome aboard ${data.discord-application_emojis.all.markup["wave"]}"
+}
@@ -0,0 +1,6 @@
+data "discord-application_entitlements" "staging_guild" {
+  application_id = "1234567890987654321"
+  guild_id       = "9876543210123456789"
+  sku_ids        = [data.discord-application_skus.premium.ids["premium"]]
+  exclude_ended  = true
+}
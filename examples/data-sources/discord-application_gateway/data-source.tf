@@ -0,0 +1,5 @@
+data "discord-application_gateway" "bot" {}
+
+output "bot_replicas" {
+  value = ceil(data.discord-application_gateway.bot.shards / 4)
+}
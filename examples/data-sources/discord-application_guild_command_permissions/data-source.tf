@@ -0,0 +1,11 @@
+data "discord-application_guild_command_permissions" "moderation" {
+  application_id = "9876543210123456789"
+  guild_id       = "1234567890987654321"
+}
+
+# optionally narrow the result down to a single command
+data "discord-application_guild_command_permissions" "ban" {
+  application_id = "9876543210123456789"
+  guild_id       = "1234567890987654321"
+  command_id     = discord-application_command.ban.command_id
+}
@@ -0,0 +1,9 @@
+data "discord-application_sku_subscriptions" "premium" {
+  sku_id      = data.discord-application_skus.premium.ids["premium"]
+  user_id     = "9876543210123456789"
+  max_results = 500
+}
+
+output "active_subscriptions" {
+  value = [for subscription in data.discord-application_sku_subscriptions.premium.subscriptions : subscription.id if subscription.status == "active"]
+}
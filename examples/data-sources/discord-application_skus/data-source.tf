@@ -0,0 +1,7 @@
+data "discord-application_skus" "premium" {
+  application_id = "1234567890987654321"
+}
+
+output "premium_sku_id" {
+  value = data.discord-application_skus.premium.ids["premium"]
+}
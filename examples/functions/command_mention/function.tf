@@ -0,0 +1,16 @@
+# provider functions require Terraform 1.8 or later
+locals {
+  # "</role add:1234567890987654321>"
+  role_add_mention = provider::discord-application::command_mention(
+    discord-application_command.role.name,
+    discord-application_command.role.command_id,
+    "add",
+  )
+
+  # "</poke:1234567890987654322>"
+  poke_mention = provider::discord-application::command_mention(
+    discord-application_command.poke.name,
+    discord-application_command.poke.command_id,
+    null,
+  )
+}
@@ -0,0 +1,11 @@
+# provider functions require Terraform 1.8 or later
+output "invite_url" {
+  # "https://discord.com/oauth2/authorize?client_id=9876543210123456789&integration_type=0&permissions=1099511627782&scope=bot+applications.commands"
+  value = provider::discord-application::oauth2_install_url(
+    "9876543210123456789",
+    ["bot", "applications.commands"],
+    provider::discord-application::permissions(["KICK_MEMBERS", "BAN_MEMBERS", "MODERATE_MEMBERS"]),
+    null,
+    0,
+  )
+}
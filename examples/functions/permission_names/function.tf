@@ -0,0 +1,5 @@
+# provider functions require Terraform 1.8 or later
+output "ban_permissions" {
+  # ["BAN_MEMBERS", "MODERATE_MEMBERS"]
+  value = provider::discord-application::permission_names(discord-application_command.ban.default_member_permissions)
+}
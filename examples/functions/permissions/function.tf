@@ -0,0 +1,9 @@
+# provider functions require Terraform 1.8 or later
+resource "discord-application_command" "ban" {
+  application_id = "9876543210123456789"
+  name           = "ban"
+  description    = "Ban a member"
+  type           = 1
+
+  default_member_permissions = provider::discord-application::permissions(["BAN_MEMBERS", "MODERATE_MEMBERS"])
+}
@@ -0,0 +1,30 @@
+# provider functions require Terraform 1.8 or later
+locals {
+  # {
+  #   timestamp    = "2016-04-30T11:18:25.796Z"
+  #   timestamp_ms = 1462015105796
+  #   worker_id    = 1
+  #   process_id   = 0
+  #   increment    = 7
+  # }
+  guild = provider::discord-application::snowflake_parts("175928847299117063")
+}
+
+resource "discord-application_command_permissions" "ban" {
+  application_id = "9876543210123456789"
+  guild_id       = "175928847299117063"
+  command_id     = discord-application_command.ban.command_id
+
+  permission {
+    id         = "@everyone"
+    type       = "role"
+    permission = false
+  }
+
+  lifecycle {
+    precondition {
+      condition     = timecmp(local.guild.timestamp, "2015-01-01T00:00:00Z") > 0
+      error_message = "guild_id does not look like a Discord snowflake"
+    }
+  }
+}
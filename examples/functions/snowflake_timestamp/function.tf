@@ -0,0 +1,4 @@
+# provider functions require Terraform 1.8 or later
+output "ban_command_created_at" {
+  value = provider::discord-application::snowflake_timestamp(discord-application_command.ban.command_id)
+}
@@ -1,3 +1,9 @@
 provider "discord-application" {
   token = "jK9sL8nRcE4tU7xW2pV0qGhF1m.xY8zK2.pR5tU9qJ7sN2mZ8cE4xK0lO1vF6hY3wA2bD2Dv"
-}
\ No newline at end of file
+
+  # only required to manage command permissions
+  bearer_token = "Zx81mQ3pLw9vT2rKc7YhN4bF0sJd6E"
+
+  # warns when the tokens belong to a different application
+  application_id = "1234567890987654321"
+}
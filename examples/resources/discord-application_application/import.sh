@@ -0,0 +1,2 @@
+# ID when importing is the application_id of the application the provider token belongs to
+terraform import --var-file=vars.tfvars  discord-application_application.example "application_id"
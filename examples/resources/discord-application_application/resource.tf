@@ -0,0 +1,31 @@
+resource "discord-application_application" "bot" {
+  description               = "Moderation helpers for the community server"
+  interactions_endpoint_url = "https://interactions.example.com/discord"
+  custom_install_url        = ""
+
+  icon_file        = "${path.module}/images/icon.png"
+  cover_image_file = "${path.module}/images/cover.png"
+
+  event_webhooks_url    = "https://events.example.com/discord"
+  event_webhooks_status = "enabled"
+  event_webhooks_types  = ["APPLICATION_AUTHORIZED", "ENTITLEMENT_CREATE"]
+
+  tags  = ["moderation", "utility"]
+  flags = ["GATEWAY_MESSAGE_CONTENT_LIMITED"]
+
+  install_params {
+    scopes      = ["bot", "applications.commands"]
+    permissions = ["SEND_MESSAGES", "KICK_MEMBERS", "BAN_MEMBERS", "MODERATE_MEMBERS"]
+  }
+
+  integration_types_config {
+    guild_install {
+      scopes      = ["bot", "applications.commands"]
+      permissions = ["SEND_MESSAGES", "KICK_MEMBERS", "BAN_MEMBERS", "MODERATE_MEMBERS"]
+    }
+
+    user_install {
+      scopes = ["applications.commands"]
+    }
+  }
+}
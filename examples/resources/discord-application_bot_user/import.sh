@@ -0,0 +1,2 @@
+# ID when importing is the user ID of the bot user the provider token belongs to
+terraform import --var-file=vars.tfvars  discord-application_bot_user.example "bot_user_id"
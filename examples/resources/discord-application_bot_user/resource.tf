@@ -0,0 +1,5 @@
+resource "discord-application_bot_user" "bot" {
+  username    = "Moderation Helper"
+  avatar_file = "${path.module}/images/avatar.png"
+  banner_file = "${path.module}/images/banner.gif"
+}
@@ -3,4 +3,42 @@ resource "discord-application_command" "poke" {
   name = "poke"
   description = "a poke to the application"
   type = 1
-}
\ No newline at end of file
+}
+
+resource "discord-application_command" "price" {
+  application_id = "9876543210123456789"
+  name           = "price"
+  description    = "quote a price"
+  type           = 1
+
+  option {
+    type        = 10 # NUMBER
+    name        = "amount"
+    description = "the amount to quote"
+    required    = true
+    min_value   = 0.5
+    max_value   = 999.99
+  }
+
+  option {
+    type        = 3 # STRING
+    name        = "currency"
+    description = "the currency to quote in"
+
+    choice {
+      name  = "Pound Sterling"
+      value = "GBP"
+    }
+
+    choice {
+      name  = "Euro"
+      value = "EUR"
+    }
+  }
+}
+
+# commands can also be defined from the JSON exported by discord.js or discord.py
+resource "discord-application_command" "ban" {
+  application_id  = "9876543210123456789"
+  definition_json = file("${path.module}/commands/ban.json")
+}
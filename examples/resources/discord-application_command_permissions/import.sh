@@ -0,0 +1,2 @@
+# ID when importing is structured with application_id-guild_id-command_id
+terraform import --var-file=vars.tfvars  discord-application_command_permissions.example "application_id-guild_id-command_id"
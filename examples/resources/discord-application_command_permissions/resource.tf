@@ -0,0 +1,43 @@
+resource "discord-application_command_permissions" "ban" {
+  application_id = "9876543210123456789"
+  guild_id       = "1234567890987654321"
+  command_id     = discord-application_command.ban.command_id
+
+  # deny everyone by default
+  permission {
+    id         = "@everyone"
+    type       = "role"
+    permission = false
+  }
+
+  permission {
+    id         = "1122334455667788990"
+    type       = "role"
+    permission = true
+  }
+
+  permission {
+    id         = "@all_channels"
+    type       = "channel"
+    permission = false
+  }
+
+  permission {
+    id         = "5544332211009988776"
+    type       = "channel"
+    permission = true
+  }
+}
+
+# application-wide defaults are managed by setting command_id to the application ID
+resource "discord-application_command_permissions" "defaults" {
+  application_id = "9876543210123456789"
+  guild_id       = "1234567890987654321"
+  command_id     = "9876543210123456789"
+
+  permission {
+    id         = "1122334455667788990"
+    type       = "user"
+    permission = true
+  }
+}
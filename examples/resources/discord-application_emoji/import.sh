@@ -0,0 +1,2 @@
+# ID when importing is structured with application_id-emoji_id
+terraform import --var-file=vars.tfvars  discord-application_emoji.example "application_id-emoji_id"
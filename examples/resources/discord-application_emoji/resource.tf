@@ -0,0 +1,12 @@
+resource "discord-application_emoji" "wave" {
+  application_id = "9876543210123456789"
+  name           = "wave"
+  source         = "${path.module}/emojis/wave.png"
+}
+
+# base64 encoded content is accepted as well as file paths
+resource "discord-application_emoji" "party" {
+  application_id = "9876543210123456789"
+  name           = "party"
+  source         = filebase64("${path.module}/emojis/party.gif")
+}
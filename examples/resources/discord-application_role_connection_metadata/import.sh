@@ -0,0 +1,2 @@
+# ID when importing is the application_id
+terraform import --var-file=vars.tfvars  discord-application_role_connection_metadata.example "application_id"
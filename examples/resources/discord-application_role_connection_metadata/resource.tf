@@ -0,0 +1,20 @@
+resource "discord-application_role_connection_metadata" "linked_roles" {
+  application_id = "9876543210123456789"
+
+  record {
+    key         = "matches_played"
+    type        = "integer_greater_than_or_equal"
+    name        = "Matches played"
+    description = "Number of ranked matches played"
+    name_localizations = {
+      "fr" = "Matchs joués"
+    }
+  }
+
+  record {
+    key         = "verified"
+    type        = "boolean_equal"
+    name        = "Verified"
+    description = "Has a verified game account"
+  }
+}
@@ -0,0 +1,2 @@
+# ID when importing is structured with application_id-entitlement_id
+terraform import --var-file=vars.tfvars  discord-application_test_entitlement.example "application_id-entitlement_id"
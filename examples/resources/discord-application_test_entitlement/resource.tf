@@ -0,0 +1,10 @@
+data "discord-application_skus" "premium" {
+  application_id = "1234567890987654321"
+}
+
+resource "discord-application_test_entitlement" "staging_guild" {
+  application_id = "1234567890987654321"
+  sku_id         = data.discord-application_skus.premium.ids["premium"]
+  owner_type     = "guild"
+  owner_id       = "9876543210123456789"
+}
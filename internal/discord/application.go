@@ -0,0 +1,115 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+)
+
+// Application flags which an application may set on itself. Every other flag is assigned by Discord.
+const (
+	ApplicationFlagGatewayPresenceLimited       = 1 << 13
+	ApplicationFlagGatewayGuildMembersLimited   = 1 << 15
+	ApplicationFlagGatewayMessageContentLimited = 1 << 19
+)
+
+// EditableApplicationFlags maps the names of the flags an application may set on itself to their values.
+var EditableApplicationFlags = map[string]int{
+	"GATEWAY_PRESENCE_LIMITED":        ApplicationFlagGatewayPresenceLimited,
+	"GATEWAY_GUILD_MEMBERS_LIMITED":   ApplicationFlagGatewayGuildMembersLimited,
+	"GATEWAY_MESSAGE_CONTENT_LIMITED": ApplicationFlagGatewayMessageContentLimited,
+}
+
+// Keys of an application's integration_types_config, one per installation context.
+const (
+	ApplicationIntegrationTypeGuildInstall = "0"
+	ApplicationIntegrationTypeUserInstall  = "1"
+)
+
+type ApplicationEventWebhookStatus int
+
+const (
+	ApplicationEventWebhookStatusDisabled          ApplicationEventWebhookStatus = 1
+	ApplicationEventWebhookStatusEnabled           ApplicationEventWebhookStatus = 2
+	ApplicationEventWebhookStatusDisabledByDiscord ApplicationEventWebhookStatus = 3
+)
+
+// WebhookEventTypes lists the event types an application can subscribe its event webhooks to.
+var WebhookEventTypes = []string{
+	"APPLICATION_AUTHORIZED",
+	"APPLICATION_DEAUTHORIZED",
+	"ENTITLEMENT_CREATE",
+	"ENTITLEMENT_UPDATE",
+	"ENTITLEMENT_DELETE",
+	"QUEST_USER_ENROLLMENT",
+	"LOBBY_MESSAGE_CREATE",
+	"LOBBY_MESSAGE_UPDATE",
+	"LOBBY_MESSAGE_DELETE",
+	"GAME_DIRECT_MESSAGE_CREATE",
+	"GAME_DIRECT_MESSAGE_UPDATE",
+	"GAME_DIRECT_MESSAGE_DELETE",
+}
+
+// InstallParams are the OAuth2 scopes and bot permissions requested when the application is installed in-app.
+type InstallParams struct {
+	Scopes      []string `json:"scopes"`
+	Permissions string   `json:"permissions"`
+}
+
+// ApplicationIntegrationTypeConfig configures one installation context. A context without install params is
+// supported but only installable through custom or manually built install links.
+type ApplicationIntegrationTypeConfig struct {
+	OAuth2InstallParams *InstallParams `json:"oauth2_install_params,omitempty"`
+}
+
+type Application struct {
+	ID                             string                                       `json:"id"`
+	Name                           string                                       `json:"name"`
+	Description                    string                                       `json:"description"`
+	Icon                           *string                                      `json:"icon,omitempty"`
+	CoverImage                     *string                                      `json:"cover_image,omitempty"`
+	VerifyKey                      string                                       `json:"verify_key"`
+	Bot                            *User                                        `json:"bot,omitempty"`
+	Owner                          *User                                        `json:"owner,omitempty"`
+	Team                           *Team                                        `json:"team,omitempty"`
+	Flags                          int                                          `json:"flags"`
+	ApproximateGuildCount          *int                                         `json:"approximate_guild_count,omitempty"`
+	RedirectURIs                   *[]string                                    `json:"redirect_uris,omitempty"`
+	InteractionsEndpointURL        *string                                      `json:"interactions_endpoint_url,omitempty"`
+	RoleConnectionsVerificationURL *string                                      `json:"role_connections_verification_url,omitempty"`
+	CustomInstallURL               *string                                      `json:"custom_install_url,omitempty"`
+	EventWebhooksURL               *string                                      `json:"event_webhooks_url,omitempty"`
+	EventWebhooksStatus            ApplicationEventWebhookStatus                `json:"event_webhooks_status,omitempty"`
+	EventWebhooksTypes             *[]string                                    `json:"event_webhooks_types,omitempty"`
+	Tags                           *[]string                                    `json:"tags,omitempty"`
+	InstallParams                  *InstallParams                               `json:"install_params,omitempty"`
+	IntegrationTypesConfig         *map[string]ApplicationIntegrationTypeConfig `json:"integration_types_config,omitempty"`
+}
+
+// EditApplication holds the fields of the current application which can be edited. Nil fields are left unchanged.
+type EditApplication struct {
+	Description                    *string                                      `json:"description,omitempty"`
+	Icon                           *string                                      `json:"icon,omitempty"`
+	CoverImage                     *string                                      `json:"cover_image,omitempty"`
+	Flags                          *int                                         `json:"flags,omitempty"`
+	InteractionsEndpointURL        *string                                      `json:"interactions_endpoint_url,omitempty"`
+	RoleConnectionsVerificationURL *string                                      `json:"role_connections_verification_url,omitempty"`
+	CustomInstallURL               *string                                      `json:"custom_install_url,omitempty"`
+	EventWebhooksURL               *string                                      `json:"event_webhooks_url,omitempty"`
+	EventWebhooksStatus            *ApplicationEventWebhookStatus               `json:"event_webhooks_status,omitempty"`
+	EventWebhooksTypes             *[]string                                    `json:"event_webhooks_types,omitempty"`
+	Tags                           *[]string                                    `json:"tags,omitempty"`
+	InstallParams                  *InstallParams                               `json:"install_params,omitempty"`
+	IntegrationTypesConfig         *map[string]ApplicationIntegrationTypeConfig `json:"integration_types_config,omitempty"`
+}
+
+// GetCurrentApplication fetches the application the bot token belongs to.
+func (c *Client) GetCurrentApplication(ctx context.Context) (output *Application, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, "/applications/@me", nil, &output)
+	return output, resp, err
+}
+
+// EditCurrentApplication edits the application the bot token belongs to.
+func (c *Client) EditCurrentApplication(ctx context.Context, request *EditApplication) (output *Application, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPatch, "/applications/@me", request, &output)
+	return output, resp, err
+}
@@ -0,0 +1,124 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const apiVersion = "10"
+
+type tokenType string
+
+const (
+	tokenTypeBot    tokenType = "Bot"
+	tokenTypeBearer tokenType = "Bearer"
+)
+
+// Config holds everything needed to talk to the Discord API.
+type Config struct {
+	Token       string
+	BearerToken string // optional - only required by endpoints that reject bot tokens
+	BaseUrl     string
+	HTTPClient  *http.Client
+}
+
+// Client is a thin client over the parts of the Discord REST API the provider manages.
+type Client struct {
+	config *Config
+
+	// logMasks are the configured tokens, masked wherever they appear in the request logs
+	logMasks []string
+}
+
+func NewClient(cfg *Config) *Client {
+	var logMasks []string
+	for _, token := range []string{cfg.Token, cfg.BearerToken} {
+		if token != "" {
+			logMasks = append(logMasks, token)
+		}
+	}
+
+	return &Client{
+		config:   cfg,
+		logMasks: logMasks,
+	}
+}
+
+// HasBearerToken reports whether a user Bearer token was configured.
+func (c *Client) HasBearerToken() bool {
+	return c.config.BearerToken != ""
+}
+
+func (c *Client) versionedUrl() string {
+	return fmt.Sprintf("%s/v%s", c.config.BaseUrl, apiVersion)
+}
+
+// do performs a request against the given API path. The request value is JSON encoded as the body when non-nil and
+// the response body is decoded into output for 2xx responses. Non-2xx responses are returned without an error so
+// callers can inspect the status code.
+func (c *Client) do(ctx context.Context, auth tokenType, method, path string, request, output any) (*http.Response, error) {
+	if c.config.HTTPClient == nil {
+		return nil, errors.New("cannot perform request without a http client")
+	}
+
+	var body io.Reader
+	var requestBytes []byte
+	if request != nil {
+		var err error
+		requestBytes, err = json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(requestBytes)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, method, c.versionedUrl()+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	token := c.config.Token
+	if auth == tokenTypeBearer {
+		if !c.HasBearerToken() {
+			return nil, errors.New("this endpoint requires a Bearer token but none was configured")
+		}
+		token = c.config.BearerToken
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Authorization", fmt.Sprintf("%s %s", auth, token))
+
+	logCtx := c.logContext(ctx)
+	started := time.Now()
+
+	response, err := c.config.HTTPClient.Do(httpRequest)
+	if err != nil {
+		logRequest(logCtx, method, path, started, requestBytes, nil, nil, err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBytes, err := io.ReadAll(response.Body)
+	logRequest(logCtx, method, path, started, requestBytes, response, responseBytes, err)
+	if err != nil {
+		return response, err
+	}
+
+	if output != nil && response.StatusCode >= 200 && response.StatusCode < 300 && len(responseBytes) > 0 {
+		// Numbers are decoded as json.Number so values such as option bounds keep their exact representation
+		decoder := json.NewDecoder(bytes.NewReader(responseBytes))
+		decoder.UseNumber()
+		err = decoder.Decode(output)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type ApplicationCommandPermissionType int
+
+const (
+	ApplicationCommandPermissionTypeRole    ApplicationCommandPermissionType = 1
+	ApplicationCommandPermissionTypeUser    ApplicationCommandPermissionType = 2
+	ApplicationCommandPermissionTypeChannel ApplicationCommandPermissionType = 3
+)
+
+const (
+	// PermissionEveryone is the named constant for the guild's @everyone role, which Discord identifies by the guild ID.
+	PermissionEveryone = "@everyone"
+	// PermissionAllChannels is the named constant for every channel in the guild, which Discord identifies by guild ID - 1.
+	PermissionAllChannels = "@all_channels"
+)
+
+type ApplicationCommandPermission struct {
+	ID         string                           `json:"id"`
+	Type       ApplicationCommandPermissionType `json:"type"`
+	Permission bool                             `json:"permission"`
+}
+
+type GuildApplicationCommandPermissions struct {
+	ID            string                         `json:"id"`
+	ApplicationID string                         `json:"application_id"`
+	GuildID       string                         `json:"guild_id"`
+	Permissions   []ApplicationCommandPermission `json:"permissions"`
+}
+
+type EditApplicationCommandPermissions struct {
+	Permissions []ApplicationCommandPermission `json:"permissions"`
+}
+
+// EveryoneID returns the ID Discord uses for the @everyone role within the given guild.
+func EveryoneID(guildID string) string {
+	return guildID
+}
+
+// AllChannelsID returns the ID Discord uses to target every channel within the given guild.
+func AllChannelsID(guildID string) (string, error) {
+	id, err := strconv.ParseUint(guildID, 10, 64)
+	if err != nil || id == 0 {
+		return "", fmt.Errorf("invalid guild ID %q", guildID)
+	}
+	return strconv.FormatUint(id-1, 10), nil
+}
+
+// ResolvePermissionID converts the named sentinel constants into the IDs Discord expects. Any other value is returned
+// as provided.
+func ResolvePermissionID(guildID, id string) (string, error) {
+	switch id {
+	case PermissionEveryone:
+		return EveryoneID(guildID), nil
+	case PermissionAllChannels:
+		return AllChannelsID(guildID)
+	default:
+		return id, nil
+	}
+}
+
+// SentinelName returns the named constant matching the given permission, or an empty string if it targets a regular
+// role, user or channel.
+func SentinelName(guildID string, permission ApplicationCommandPermission) string {
+	switch permission.Type {
+	case ApplicationCommandPermissionTypeRole:
+		if permission.ID == EveryoneID(guildID) {
+			return PermissionEveryone
+		}
+	case ApplicationCommandPermissionTypeChannel:
+		if allChannels, err := AllChannelsID(guildID); err == nil && permission.ID == allChannels {
+			return PermissionAllChannels
+		}
+	}
+	return ""
+}
+
+// GetGuildCommandPermissions fetches the permission overwrites of every command the application has within a guild.
+func (c *Client) GetGuildCommandPermissions(ctx context.Context, applicationID, guildID string) (output *[]GuildApplicationCommandPermissions, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/guilds/%s/commands/permissions", applicationID, guildID), nil, &output)
+	return output, resp, err
+}
+
+// GetCommandPermissions fetches the permission overwrites of a single command within a guild.
+func (c *Client) GetCommandPermissions(ctx context.Context, applicationID, guildID, commandID string) (output *GuildApplicationCommandPermissions, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/guilds/%s/commands/%s/permissions", applicationID, guildID, commandID), nil, &output)
+	return output, resp, err
+}
+
+// EditCommandPermissions overwrites the permissions of a command within a guild. Discord only accepts a user Bearer
+// token for this endpoint.
+func (c *Client) EditCommandPermissions(ctx context.Context, applicationID, guildID, commandID string, request *EditApplicationCommandPermissions) (output *GuildApplicationCommandPermissions, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBearer, http.MethodPut, fmt.Sprintf("/applications/%s/guilds/%s/commands/%s/permissions", applicationID, guildID, commandID), request, &output)
+	return output, resp, err
+}
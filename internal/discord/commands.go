@@ -0,0 +1,126 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ApplicationCommandOptionType int
+
+const (
+	ApplicationCommandOptionTypeSubCommand      ApplicationCommandOptionType = 1
+	ApplicationCommandOptionTypeSubCommandGroup ApplicationCommandOptionType = 2
+	ApplicationCommandOptionTypeString          ApplicationCommandOptionType = 3
+	ApplicationCommandOptionTypeInteger         ApplicationCommandOptionType = 4
+	ApplicationCommandOptionTypeBoolean         ApplicationCommandOptionType = 5
+	ApplicationCommandOptionTypeUser            ApplicationCommandOptionType = 6
+	ApplicationCommandOptionTypeChannel         ApplicationCommandOptionType = 7
+	ApplicationCommandOptionTypeRole            ApplicationCommandOptionType = 8
+	ApplicationCommandOptionTypeMentionable     ApplicationCommandOptionType = 9
+	ApplicationCommandOptionTypeNumber          ApplicationCommandOptionType = 10
+	ApplicationCommandOptionTypeAttachment      ApplicationCommandOptionType = 11
+)
+
+type ApplicationCommand struct {
+	ID                       string                      `json:"id,omitempty"`
+	Type                     int                         `json:"type,omitempty"`
+	ApplicationID            string                      `json:"application_id,omitempty"`
+	GuildID                  *string                     `json:"guild_id,omitempty"`
+	Name                     string                      `json:"name"`
+	NameLocalizations        *map[string]string          `json:"name_localizations,omitempty"`
+	Description              string                      `json:"description"`
+	DescriptionLocalizations *map[string]string          `json:"description_localizations,omitempty"`
+	Options                  *[]ApplicationCommandOption `json:"options,omitempty"`
+	DefaultMemberPermissions *string                     `json:"default_member_permissions,omitempty"`
+	DmPermission             *bool                       `json:"dm_permission,omitempty"`
+	DefaultPermission        *bool                       `json:"default_permission,omitempty"`
+	Nsfw                     *bool                       `json:"nsfw,omitempty"`
+	IntegrationTypes         *[]int                      `json:"integration_types,omitempty"`
+	Contexts                 *[]int                      `json:"contexts,omitempty"`
+	Version                  string                      `json:"version,omitempty"`
+}
+
+// ApplicationCommandOption mirrors the discord-application-sdk model, except that MinValue and MaxValue keep the exact
+// JSON number so fractional bounds on NUMBER options survive the round trip.
+type ApplicationCommandOption struct {
+	Type                     ApplicationCommandOptionType      `json:"type"`
+	Name                     string                            `json:"name"`
+	NameLocalizations        *map[string]string                `json:"name_localizations,omitempty"`
+	Description              string                            `json:"description"`
+	DescriptionLocalizations *map[string]string                `json:"description_localizations,omitempty"`
+	Required                 *bool                             `json:"required,omitempty"`
+	Choices                  *[]ApplicationCommandOptionChoice `json:"choices,omitempty"`
+	Options                  *[]ApplicationCommandOption       `json:"options,omitempty"` //When defining multiple, ensure required values are listed before optional values.
+	ChannelTypes             *[]int                            `json:"channel_types,omitempty"`
+	MinValue                 *json.Number                      `json:"min_value,omitempty"`
+	MaxValue                 *json.Number                      `json:"max_value,omitempty"`
+	MinLength                *int                              `json:"min_length,omitempty"`
+	MaxLength                *int                              `json:"max_length,omitempty"`
+	AutoComplete             *bool                             `json:"autocomplete,omitempty"` //Must be false if choices are defined
+}
+
+type ApplicationCommandOptionChoice struct {
+	Name              string             `json:"name"`
+	NameLocalizations *map[string]string `json:"name_localizations,omitempty"`
+	Value             any                `json:"value"` //Either a string or a json.Number depending on the option type
+}
+
+type CreateApplicationCommand struct {
+	Name                     string                      `json:"name"`
+	NameLocalizations        *map[string]string          `json:"name_localizations,omitempty"`
+	Description              string                      `json:"description"`
+	DescriptionLocalizations *map[string]string          `json:"description_localizations,omitempty"`
+	Options                  *[]ApplicationCommandOption `json:"options,omitempty"`
+	DefaultMemberPermissions *string                     `json:"default_member_permissions,omitempty"`
+	DmPermission             *bool                       `json:"dm_permission,omitempty"`
+	Type                     *int                        `json:"type,omitempty"` //defaults to 1
+	Nsfw                     *bool                       `json:"nsfw,omitempty"`
+	IntegrationTypes         *[]int                      `json:"integration_types,omitempty"`
+	Contexts                 *[]int                      `json:"contexts,omitempty"`
+}
+
+type PatchApplicationCommand struct {
+	Name                     *string                     `json:"name,omitempty"`
+	NameLocalizations        *map[string]string          `json:"name_localizations,omitempty"`
+	Description              *string                     `json:"description,omitempty"`
+	DescriptionLocalizations *map[string]string          `json:"description_localizations,omitempty"`
+	Options                  *[]ApplicationCommandOption `json:"options,omitempty"`
+	DefaultMemberPermissions *string                     `json:"default_member_permissions"` //null resets the command to be available to everyone
+	DmPermission             *bool                       `json:"dm_permission,omitempty"`
+	Nsfw                     *bool                       `json:"nsfw,omitempty"`
+	IntegrationTypes         *[]int                      `json:"integration_types,omitempty"`
+	Contexts                 *[]int                      `json:"contexts,omitempty"`
+}
+
+// GetCommands fetches every global command of the application, including the full name and description localizations
+// that GetCommand returns.
+func (c *Client) GetCommands(ctx context.Context, applicationID string) (output *[]ApplicationCommand, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/commands?with_localizations=true", applicationID), nil, &output)
+	return output, resp, err
+}
+
+// GetCommand fetches a single global command of the application.
+func (c *Client) GetCommand(ctx context.Context, applicationID, commandID string) (output *ApplicationCommand, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/commands/%s", applicationID, commandID), nil, &output)
+	return output, resp, err
+}
+
+// CreateCommand creates a global command. Discord responds with 201 for new commands and 200 when a command of the
+// same name already existed and was overwritten.
+func (c *Client) CreateCommand(ctx context.Context, applicationID string, request *CreateApplicationCommand) (output *ApplicationCommand, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPost, fmt.Sprintf("/applications/%s/commands", applicationID), request, &output)
+	return output, resp, err
+}
+
+// PatchCommand edits a global command.
+func (c *Client) PatchCommand(ctx context.Context, applicationID, commandID string, request *PatchApplicationCommand) (output *ApplicationCommand, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPatch, fmt.Sprintf("/applications/%s/commands/%s", applicationID, commandID), request, &output)
+	return output, resp, err
+}
+
+// DeleteCommand deletes a global command.
+func (c *Client) DeleteCommand(ctx context.Context, applicationID, commandID string) (resp *http.Response, err error) {
+	return c.do(ctx, tokenTypeBot, http.MethodDelete, fmt.Sprintf("/applications/%s/commands/%s", applicationID, commandID), nil, nil)
+}
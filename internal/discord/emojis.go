@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type Emoji struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Animated  bool   `json:"animated"`
+	Available bool   `json:"available"`
+	User      *User  `json:"user,omitempty"`
+}
+
+// Markup returns the message markup which renders the emoji.
+func (e *Emoji) Markup() string {
+	if e.Animated {
+		return fmt.Sprintf("<a:%s:%s>", e.Name, e.ID)
+	}
+	return fmt.Sprintf("<:%s:%s>", e.Name, e.ID)
+}
+
+type ApplicationEmojis struct {
+	Items []Emoji `json:"items"`
+}
+
+type CreateApplicationEmoji struct {
+	Name  string `json:"name"`
+	Image string `json:"image"` // image data URI
+}
+
+type PatchApplicationEmoji struct {
+	Name string `json:"name"`
+}
+
+// GetEmojis fetches every emoji owned by the application.
+func (c *Client) GetEmojis(ctx context.Context, applicationID string) (output *ApplicationEmojis, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/emojis", applicationID), nil, &output)
+	return output, resp, err
+}
+
+// GetEmoji fetches a single emoji owned by the application.
+func (c *Client) GetEmoji(ctx context.Context, applicationID, emojiID string) (output *Emoji, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/emojis/%s", applicationID, emojiID), nil, &output)
+	return output, resp, err
+}
+
+// CreateEmoji uploads a new emoji owned by the application.
+func (c *Client) CreateEmoji(ctx context.Context, applicationID string, request *CreateApplicationEmoji) (output *Emoji, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPost, fmt.Sprintf("/applications/%s/emojis", applicationID), request, &output)
+	return output, resp, err
+}
+
+// PatchEmoji renames an emoji owned by the application.
+func (c *Client) PatchEmoji(ctx context.Context, applicationID, emojiID string, request *PatchApplicationEmoji) (output *Emoji, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPatch, fmt.Sprintf("/applications/%s/emojis/%s", applicationID, emojiID), request, &output)
+	return output, resp, err
+}
+
+// DeleteEmoji deletes an emoji owned by the application.
+func (c *Client) DeleteEmoji(ctx context.Context, applicationID, emojiID string) (resp *http.Response, err error) {
+	return c.do(ctx, tokenTypeBot, http.MethodDelete, fmt.Sprintf("/applications/%s/emojis/%s", applicationID, emojiID), nil, nil)
+}
@@ -0,0 +1,124 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type EntitlementType int
+
+const (
+	EntitlementTypePurchase                EntitlementType = 1
+	EntitlementTypePremiumSubscription     EntitlementType = 2
+	EntitlementTypeDeveloperGift           EntitlementType = 3
+	EntitlementTypeTestModePurchase        EntitlementType = 4
+	EntitlementTypeFreePurchase            EntitlementType = 5
+	EntitlementTypeUserGift                EntitlementType = 6
+	EntitlementTypePremiumPurchase         EntitlementType = 7
+	EntitlementTypeApplicationSubscription EntitlementType = 8
+)
+
+type Entitlement struct {
+	ID            string          `json:"id"`
+	SKUID         string          `json:"sku_id"`
+	ApplicationID string          `json:"application_id"`
+	UserID        *string         `json:"user_id,omitempty"`
+	GuildID       *string         `json:"guild_id,omitempty"`
+	Type          EntitlementType `json:"type"`
+	Deleted       bool            `json:"deleted"`
+	Consumed      *bool           `json:"consumed,omitempty"`
+	StartsAt      *string         `json:"starts_at,omitempty"`
+	EndsAt        *string         `json:"ends_at,omitempty"`
+}
+
+// ListEntitlementsParams filters the entitlements returned by ListEntitlements. Empty values are left out of the query.
+type ListEntitlementsParams struct {
+	Page
+	UserID         string
+	SKUIDs         []string
+	GuildID        string
+	ExcludeEnded   bool
+	ExcludeDeleted bool
+}
+
+func (p *ListEntitlementsParams) query() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if p.UserID != "" {
+		query.Set("user_id", p.UserID)
+	}
+	if len(p.SKUIDs) > 0 {
+		query.Set("sku_ids", strings.Join(p.SKUIDs, ","))
+	}
+	if p.GuildID != "" {
+		query.Set("guild_id", p.GuildID)
+	}
+	p.Page.setQuery(query)
+	if p.ExcludeEnded {
+		query.Set("exclude_ended", "true")
+	}
+	if p.ExcludeDeleted {
+		query.Set("exclude_deleted", "true")
+	}
+	return query
+}
+
+// ListEntitlements fetches a page of the application's entitlements matching params.
+func (c *Client) ListEntitlements(ctx context.Context, applicationID string, params *ListEntitlementsParams) (output *[]Entitlement, resp *http.Response, err error) {
+	path := fmt.Sprintf("/applications/%s/entitlements", applicationID)
+	if query := params.query(); len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, path, nil, &output)
+	return output, resp, err
+}
+
+// ListAllEntitlements follows the pages of the application's entitlements matching params, collecting at most
+// maxResults entitlements.
+func (c *Client) ListAllEntitlements(ctx context.Context, applicationID string, params ListEntitlementsParams, maxResults int) ([]Entitlement, *http.Response, error) {
+	return ListPages(ctx, params.Page, maxResults, func(ctx context.Context, page Page) ([]Entitlement, *http.Response, error) {
+		params.Page = page
+		entitlements, resp, err := c.ListEntitlements(ctx, applicationID, &params)
+		if entitlements == nil {
+			return nil, resp, err
+		}
+		return *entitlements, resp, err
+	}, func(entitlement Entitlement) string {
+		return entitlement.ID
+	})
+}
+
+type TestEntitlementOwnerType int
+
+const (
+	TestEntitlementOwnerTypeGuild TestEntitlementOwnerType = 1
+	TestEntitlementOwnerTypeUser  TestEntitlementOwnerType = 2
+)
+
+type CreateTestEntitlement struct {
+	SKUID     string                   `json:"sku_id"`
+	OwnerID   string                   `json:"owner_id"`
+	OwnerType TestEntitlementOwnerType `json:"owner_type"`
+}
+
+// GetEntitlement fetches a single entitlement of the application.
+func (c *Client) GetEntitlement(ctx context.Context, applicationID, entitlementID string) (output *Entitlement, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/entitlements/%s", applicationID, entitlementID), nil, &output)
+	return output, resp, err
+}
+
+// CreateTestEntitlement grants a guild or user a test entitlement to a SKU, which has no start or end date.
+func (c *Client) CreateTestEntitlement(ctx context.Context, applicationID string, request *CreateTestEntitlement) (output *Entitlement, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPost, fmt.Sprintf("/applications/%s/entitlements", applicationID), request, &output)
+	return output, resp, err
+}
+
+// DeleteTestEntitlement removes a test entitlement. Only test entitlements can be deleted.
+func (c *Client) DeleteTestEntitlement(ctx context.Context, applicationID, entitlementID string) (resp *http.Response, err error) {
+	return c.do(ctx, tokenTypeBot, http.MethodDelete, fmt.Sprintf("/applications/%s/entitlements/%s", applicationID, entitlementID), nil, nil)
+}
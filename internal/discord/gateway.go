@@ -0,0 +1,25 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+)
+
+type SessionStartLimit struct {
+	Total          int `json:"total"`
+	Remaining      int `json:"remaining"`
+	ResetAfter     int `json:"reset_after"` // milliseconds
+	MaxConcurrency int `json:"max_concurrency"`
+}
+
+type GatewayBot struct {
+	URL               string            `json:"url"`
+	Shards            int               `json:"shards"`
+	SessionStartLimit SessionStartLimit `json:"session_start_limit"`
+}
+
+// GetGatewayBot fetches the gateway URL along with the recommended shard count and session start limits of the bot.
+func (c *Client) GetGatewayBot(ctx context.Context) (output *GatewayBot, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, "/gateway/bot", nil, &output)
+	return output, resp, err
+}
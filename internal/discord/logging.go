@@ -0,0 +1,110 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LogSubsystem is the tflog subsystem every API request is logged to. Its level is set with the
+// TF_LOG_PROVIDER_DISCORD environment variable.
+const LogSubsystem = "discord"
+
+// rateLimitHeaders are the response headers describing the rate limit bucket of a request.
+var rateLimitHeaders = map[string]string{
+	"X-RateLimit-Limit":       "rate_limit_limit",
+	"X-RateLimit-Remaining":   "rate_limit_remaining",
+	"X-RateLimit-Reset-After": "rate_limit_reset_after",
+	"X-RateLimit-Bucket":      "rate_limit_bucket",
+	"X-RateLimit-Scope":       "rate_limit_scope",
+	"X-RateLimit-Global":      "rate_limit_global",
+	"Retry-After":             "retry_after",
+}
+
+// logContext adds the API subsystem logger to ctx, masking the tokens wherever they appear in log fields. tflog keeps
+// its loggers in the context, so the subsystem is attached to each request context while the masks are built once by
+// NewClient.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", LogSubsystem))
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "authorization", "token", "bearer_token")
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, LogSubsystem, "authorization", "token", "bearer_token")
+
+	if len(c.logMasks) > 0 {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, c.logMasks...)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, c.logMasks...)
+	}
+	return ctx
+}
+
+// logRequest logs a completed request at DEBUG, along with the request and response bodies at TRACE.
+func logRequest(ctx context.Context, method, path string, started time.Time, requestBody []byte, response *http.Response, responseBody []byte, err error) {
+	fields := map[string]any{
+		"method":     method,
+		"route":      routeTemplate(path),
+		"latency_ms": time.Since(started).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	if response != nil {
+		fields["status"] = response.StatusCode
+		for header, field := range rateLimitHeaders {
+			if value := response.Header.Get(header); value != "" {
+				fields[field] = value
+			}
+		}
+
+		if response.StatusCode >= 400 {
+			var apiError struct {
+				Code    *int   `json:"code"`
+				Message string `json:"message"`
+			}
+			if json.Unmarshal(responseBody, &apiError) == nil && apiError.Code != nil {
+				fields["discord_error_code"] = *apiError.Code
+				fields["discord_error_message"] = apiError.Message
+			}
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Discord API request", fields)
+
+	if len(requestBody) > 0 || len(responseBody) > 0 {
+		tflog.SubsystemTrace(ctx, LogSubsystem, "Discord API request bodies", map[string]any{
+			"method":        method,
+			"route":         routeTemplate(path),
+			"request_body":  string(requestBody),
+			"response_body": string(responseBody),
+		})
+	}
+}
+
+// routeTemplate replaces the IDs in an API path with named placeholders so requests to the same route can be grouped,
+// for example /applications/123/commands/456 becomes /applications/{application_id}/commands/{command_id}.
+func routeTemplate(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if i == 0 || !isSnowflake(segment) {
+			continue
+		}
+		segments[i] = "{" + strings.TrimSuffix(segments[i-1], "s") + "_id}"
+	}
+	return strings.Join(segments, "/")
+}
+
+func isSnowflake(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// logGatewayRequest makes a single request to a local server and returns the log entries it wrote.
+func logGatewayRequest(t *testing.T) []map[string]any {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Bucket", "bot-token-bucket")
+		w.Write([]byte(`{"url":"wss://gateway.discord.gg","shards":1}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := NewClient(&Config{Token: "bot-token", BearerToken: "bearer-token", BaseUrl: server.URL, HTTPClient: server.Client()})
+	if _, _, err := client.GetGatewayBot(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if strings.Contains(output.String(), "bot-token") || strings.Contains(output.String(), "bearer-token") {
+		t.Errorf("expected the tokens to be masked, got %s", output.String())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error decoding logs: %s", err)
+	}
+	return entries
+}
+
+func TestLogRequest(t *testing.T) {
+	entries := logGatewayRequest(t)
+	if len(entries) != 2 {
+		t.Fatalf("expected a request and a body entry, got %v", entries)
+	}
+
+	request := entries[0]
+	if request["@module"] != "provider."+LogSubsystem || request["@level"] != "debug" {
+		t.Errorf("expected a debug entry in the %s subsystem, got %v", LogSubsystem, request)
+	}
+	if request["route"] != "/gateway/bot" || request["status"] != float64(http.StatusOK) {
+		t.Errorf("expected the route and status to be logged, got %v", request)
+	}
+	if request["rate_limit_bucket"] != "***-bucket" {
+		t.Errorf("expected a rate limit bucket containing the token to be masked, got %v", request["rate_limit_bucket"])
+	}
+	if entries[1]["@level"] != "trace" {
+		t.Errorf("expected the bodies to be logged at trace, got %v", entries[1])
+	}
+}
+
+func TestLogRequestLevelFromEnv(t *testing.T) {
+	tests := []struct {
+		level string
+		want  int
+	}{
+		{level: "TRACE", want: 2},
+		{level: "DEBUG", want: 1},
+		{level: "ERROR", want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.level, func(t *testing.T) {
+			t.Setenv("TF_LOG_PROVIDER_DISCORD", test.level)
+
+			if entries := logGatewayRequest(t); len(entries) != test.want {
+				t.Errorf("expected %d entries, got %v", test.want, entries)
+			}
+		})
+	}
+}
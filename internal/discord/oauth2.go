@@ -0,0 +1,20 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+)
+
+// Authorization describes the OAuth2 authorization a Bearer token belongs to.
+type Authorization struct {
+	Application *Application `json:"application,omitempty"`
+	Scopes      []string     `json:"scopes"`
+	Expires     string       `json:"expires"`
+	User        *User        `json:"user,omitempty"`
+}
+
+// GetCurrentAuthorization fetches the authorization the Bearer token belongs to.
+func (c *Client) GetCurrentAuthorization(ctx context.Context) (output *Authorization, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBearer, http.MethodGet, "/oauth2/@me", nil, &output)
+	return output, resp, err
+}
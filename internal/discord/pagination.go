@@ -0,0 +1,92 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// MaxPageLimit is the largest page Discord's paginated list endpoints return.
+const MaxPageLimit = 100
+
+// Page holds the cursor parameters shared by Discord's paginated list endpoints. Empty values are left out of the query.
+type Page struct {
+	Before string
+	After  string
+	Limit  int
+}
+
+func (p Page) setQuery(query url.Values) {
+	if p.Before != "" {
+		query.Set("before", p.Before)
+	}
+	if p.After != "" {
+		query.Set("after", p.After)
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+}
+
+// ListPages follows the pages of a paginated list endpoint, starting from the cursor in page, until a short page is
+// returned or maxResults items are collected. Pages move forward from After when set and otherwise backward from
+// Before, or from the newest item when neither is set. The response of the last request is returned, and the items
+// collected so far are returned alongside a failed request.
+func ListPages[T any](ctx context.Context, page Page, maxResults int, fetch func(context.Context, Page) ([]T, *http.Response, error), id func(T) string) ([]T, *http.Response, error) {
+	pageSize := page.Limit
+	if pageSize <= 0 || pageSize > MaxPageLimit {
+		pageSize = MaxPageLimit
+	}
+
+	items := []T{}
+	var resp *http.Response
+	for maxResults <= 0 || len(items) < maxResults {
+		page.Limit = pageSize
+		if maxResults > 0 && maxResults-len(items) < pageSize {
+			page.Limit = maxResults - len(items)
+		}
+
+		var pageItems []T
+		var err error
+		pageItems, resp, err = fetch(ctx, page)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			return items, resp, err
+		}
+		items = append(items, pageItems...)
+
+		if len(pageItems) < page.Limit {
+			return items, resp, nil
+		}
+
+		// The next cursor is the newest item of the page when moving forward and the oldest when moving backward
+		cursor := ""
+		for _, item := range pageItems {
+			itemID := id(item)
+			if cursor == "" || (page.After != "" && compareSnowflakes(itemID, cursor) > 0) || (page.After == "" && compareSnowflakes(itemID, cursor) < 0) {
+				cursor = itemID
+			}
+		}
+		if page.After != "" {
+			page.After = cursor
+		} else {
+			page.Before = cursor
+		}
+	}
+
+	return items, resp, nil
+}
+
+// compareSnowflakes orders two snowflake IDs numerically without parsing them.
+func compareSnowflakes(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
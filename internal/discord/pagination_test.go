@@ -0,0 +1,214 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// entitlementServer serves the given number of entitlements, with IDs counting up from 1000, paginating them the
+// way Discord does. Pages after a cursor are oldest first, other pages are newest first. Requests for the pages listed
+// in failPages respond with a 500.
+type entitlementServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	ids      []string
+	queries  []url.Values
+	failPage int
+}
+
+func newEntitlementServer(t *testing.T, count int) *entitlementServer {
+	t.Helper()
+
+	server := &entitlementServer{ids: []string{}}
+	for i := 0; i < count; i++ {
+		server.ids = append(server.ids, strconv.Itoa(1000+i))
+	}
+
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *entitlementServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	s.queries = append(s.queries, query)
+	if len(s.queries) == s.failPage {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit < 1 || limit > MaxPageLimit {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var page []string
+	if after := query.Get("after"); after != "" {
+		for _, id := range s.ids {
+			if compareSnowflakes(id, after) > 0 && len(page) < limit {
+				page = append(page, id)
+			}
+		}
+	} else {
+		before := query.Get("before")
+		for i := len(s.ids) - 1; i >= 0; i-- {
+			if (before == "" || compareSnowflakes(s.ids[i], before) < 0) && len(page) < limit {
+				page = append(page, s.ids[i])
+			}
+		}
+	}
+
+	entitlements := []Entitlement{}
+	for _, id := range page {
+		entitlements = append(entitlements, Entitlement{ID: id})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entitlements)
+}
+
+// cursors lists the value of a cursor query parameter and the limit of every request made, as cursor/limit.
+func (s *entitlementServer) cursors(name string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values := []string{}
+	for _, query := range s.queries {
+		values = append(values, query.Get(name)+"/"+query.Get("limit"))
+	}
+	return values
+}
+
+func (s *entitlementServer) client() *Client {
+	return NewClient(&Config{Token: "token", BaseUrl: s.URL, HTTPClient: s.Client()})
+}
+
+func entitlementIDs(entitlements []Entitlement) string {
+	ids := []string{}
+	for _, entitlement := range entitlements {
+		ids = append(ids, entitlement.ID)
+	}
+	if len(ids) > 4 {
+		return fmt.Sprintf("%d items %s...%s", len(ids), strings.Join(ids[:2], ","), strings.Join(ids[len(ids)-2:], ","))
+	}
+	return fmt.Sprintf("%d items %s", len(ids), strings.Join(ids, ","))
+}
+
+func TestListPages(t *testing.T) {
+	tests := []struct {
+		name        string
+		count       int
+		page        Page
+		maxResults  int
+		failPage    int
+		cursor      string
+		wantItems   string
+		wantCursors []string
+		wantStatus  int
+	}{
+		{
+			name:        "empty first page",
+			count:       0,
+			cursor:      "before",
+			wantItems:   "0 items ",
+			wantCursors: []string{"/100"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "single short page",
+			count:       3,
+			cursor:      "before",
+			wantItems:   "3 items 1002,1001,1000",
+			wantCursors: []string{"/100"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "backward to a short final page",
+			count:       250,
+			cursor:      "before",
+			wantItems:   "250 items 1249,1248...1001,1000",
+			wantCursors: []string{"/100", "1150/100", "1050/100"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "backward from a cursor",
+			count:       250,
+			page:        Page{Before: "1100", Limit: 40},
+			cursor:      "before",
+			wantItems:   "100 items 1099,1098...1001,1000",
+			wantCursors: []string{"1100/40", "1060/40", "1020/40"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "forward to a short final page",
+			count:       250,
+			page:        Page{After: "1000"},
+			cursor:      "after",
+			wantItems:   "249 items 1001,1002...1248,1249",
+			wantCursors: []string{"1000/100", "1100/100", "1200/100"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "full final page",
+			count:       200,
+			page:        Page{After: "0999"},
+			cursor:      "after",
+			wantItems:   "200 items 1000,1001...1198,1199",
+			wantCursors: []string{"0999/100", "1099/100", "1199/100"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "capped by max results",
+			count:       250,
+			page:        Page{After: "0999"},
+			maxResults:  150,
+			cursor:      "after",
+			wantItems:   "150 items 1000,1001...1148,1149",
+			wantCursors: []string{"0999/100", "1099/50"},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "failed page keeps collected items",
+			count:       250,
+			failPage:    2,
+			cursor:      "before",
+			wantItems:   "100 items 1249,1248...1151,1150",
+			wantCursors: []string{"/100", "1150/100"},
+			wantStatus:  http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newEntitlementServer(t, test.count)
+			server.failPage = test.failPage
+
+			entitlements, resp, err := server.client().ListAllEntitlements(context.Background(), "1234567890987654321", ListEntitlementsParams{Page: test.page}, test.maxResults)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if resp.StatusCode != test.wantStatus {
+				t.Errorf("expected status %d, got %d", test.wantStatus, resp.StatusCode)
+			}
+			if got := entitlementIDs(entitlements); got != test.wantItems {
+				t.Errorf("expected %s, got %s", test.wantItems, got)
+			}
+			if got := server.cursors(test.cursor); !slices.Equal(got, test.wantCursors) {
+				t.Errorf("expected %s cursors %v, got %v", test.cursor, test.wantCursors, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package discord
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Permissions maps every Discord permission flag name to its bit position. Discord serialises permission bitfields as
+// decimal strings because the highest bits no longer fit in a double, so the bitfield helpers below work on big.Int.
+var Permissions = map[string]uint{
+	"CREATE_INSTANT_INVITE":               0,
+	"KICK_MEMBERS":                        1,
+	"BAN_MEMBERS":                         2,
+	"ADMINISTRATOR":                       3,
+	"MANAGE_CHANNELS":                     4,
+	"MANAGE_GUILD":                        5,
+	"ADD_REACTIONS":                       6,
+	"VIEW_AUDIT_LOG":                      7,
+	"PRIORITY_SPEAKER":                    8,
+	"STREAM":                              9,
+	"VIEW_CHANNEL":                        10,
+	"SEND_MESSAGES":                       11,
+	"SEND_TTS_MESSAGES":                   12,
+	"MANAGE_MESSAGES":                     13,
+	"EMBED_LINKS":                         14,
+	"ATTACH_FILES":                        15,
+	"READ_MESSAGE_HISTORY":                16,
+	"MENTION_EVERYONE":                    17,
+	"USE_EXTERNAL_EMOJIS":                 18,
+	"VIEW_GUILD_INSIGHTS":                 19,
+	"CONNECT":                             20,
+	"SPEAK":                               21,
+	"MUTE_MEMBERS":                        22,
+	"DEAFEN_MEMBERS":                      23,
+	"MOVE_MEMBERS":                        24,
+	"USE_VAD":                             25,
+	"CHANGE_NICKNAME":                     26,
+	"MANAGE_NICKNAMES":                    27,
+	"MANAGE_ROLES":                        28,
+	"MANAGE_WEBHOOKS":                     29,
+	"MANAGE_GUILD_EXPRESSIONS":            30,
+	"USE_APPLICATION_COMMANDS":            31,
+	"REQUEST_TO_SPEAK":                    32,
+	"MANAGE_EVENTS":                       33,
+	"MANAGE_THREADS":                      34,
+	"CREATE_PUBLIC_THREADS":               35,
+	"CREATE_PRIVATE_THREADS":              36,
+	"USE_EXTERNAL_STICKERS":               37,
+	"SEND_MESSAGES_IN_THREADS":            38,
+	"USE_EMBEDDED_ACTIVITIES":             39,
+	"MODERATE_MEMBERS":                    40,
+	"VIEW_CREATOR_MONETIZATION_ANALYTICS": 41,
+	"USE_SOUNDBOARD":                      42,
+	"CREATE_GUILD_EXPRESSIONS":            43,
+	"CREATE_EVENTS":                       44,
+	"USE_EXTERNAL_SOUNDS":                 45,
+	"SEND_VOICE_MESSAGES":                 46,
+	"SET_VOICE_CHANNEL_STATUS":            48,
+	"SEND_POLLS":                          49,
+	"USE_EXTERNAL_APPS":                   50,
+	"PIN_MESSAGES":                        51,
+	"BYPASS_SLOWMODE":                     52,
+}
+
+// UnknownPermissionPattern matches the names PermissionNames gives bits missing from Permissions, such as UNKNOWN_BIT_53,
+// so that flags Discord adds later survive a round trip through PermissionBitfield.
+var UnknownPermissionPattern = regexp.MustCompile(`^UNKNOWN_BIT_([0-9]+)$`)
+
+func unknownPermissionName(bit int) string {
+	return fmt.Sprintf("UNKNOWN_BIT_%d", bit)
+}
+
+func permissionBit(name string) (uint, bool) {
+	name = strings.ToUpper(name)
+	if bit, ok := Permissions[name]; ok {
+		return bit, true
+	}
+
+	match := UnknownPermissionPattern.FindStringSubmatch(name)
+	if match == nil {
+		return 0, false
+	}
+	bit, err := strconv.ParseUint(match[1], 10, 8)
+	return uint(bit), err == nil
+}
+
+// PermissionBitfield combines the named permission flags into a decimal bitfield string.
+func PermissionBitfield(names []string) (string, error) {
+	bitfield := new(big.Int)
+	for _, name := range names {
+		bit, ok := permissionBit(name)
+		if !ok {
+			return "", fmt.Errorf("unknown permission %q", name)
+		}
+		bitfield.SetBit(bitfield, int(bit), 1)
+	}
+	return bitfield.String(), nil
+}
+
+// PermissionNames splits a decimal bitfield string into the names of its flags, ordered by bit position. Bits without a
+// known flag are named UNKNOWN_BIT_<position> rather than rejected, as Discord adds new flags over time.
+func PermissionNames(bitfield string) ([]string, error) {
+	value, ok := new(big.Int).SetString(bitfield, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a permission bitfield, expected a non-negative decimal integer", bitfield)
+	}
+
+	type flag struct {
+		name string
+		bit  uint
+	}
+	var flags []flag
+	for name, bit := range Permissions {
+		if value.Bit(int(bit)) == 1 {
+			flags = append(flags, flag{name: name, bit: bit})
+			value.SetBit(value, int(bit), 0)
+		}
+	}
+
+	for bit := 0; bit < value.BitLen(); bit++ {
+		if value.Bit(bit) == 1 {
+			flags = append(flags, flag{name: unknownPermissionName(bit), bit: uint(bit)})
+		}
+	}
+
+	sort.Slice(flags, func(i, j int) bool { return flags[i].bit < flags[j].bit })
+	names := make([]string, len(flags))
+	for i, f := range flags {
+		names[i] = f.name
+	}
+	return names, nil
+}
@@ -0,0 +1,99 @@
+package discord
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPermissionBitfield(t *testing.T) {
+	tests := []struct {
+		name      string
+		names     []string
+		want      string
+		wantError bool
+	}{
+		{name: "none", names: []string{}, want: "0"},
+		{name: "single", names: []string{"ADMINISTRATOR"}, want: "8"},
+		{name: "case insensitive", names: []string{"send_messages", "Kick_Members"}, want: "2050"},
+		{name: "voice channel status", names: []string{"SET_VOICE_CHANNEL_STATUS"}, want: "281474976710656"},
+		{name: "beyond float64 precision", names: []string{"BYPASS_SLOWMODE", "CREATE_INSTANT_INVITE"}, want: "4503599627370497"},
+		{name: "unknown bit", names: []string{"UNKNOWN_BIT_53", "CREATE_INSTANT_INVITE"}, want: "9007199254740993"},
+		{name: "unknown bit beyond 64 bits", names: []string{"unknown_bit_70"}, want: "1180591620717411303424"},
+		{name: "unknown name", names: []string{"MANAGE_EVERYTHING"}, wantError: true},
+		{name: "unknown bit out of range", names: []string{"UNKNOWN_BIT_256"}, wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := PermissionBitfield(test.names)
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if got != test.want {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPermissionNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		bitfield  string
+		want      []string
+		wantError bool
+	}{
+		{name: "none", bitfield: "0", want: []string{}},
+		{name: "ordered by bit", bitfield: "2056", want: []string{"ADMINISTRATOR", "SEND_MESSAGES"}},
+		{name: "voice channel status", bitfield: "281474976710656", want: []string{"SET_VOICE_CHANNEL_STATUS"}},
+		{name: "undocumented bit", bitfield: "140737488355328", want: []string{"UNKNOWN_BIT_47"}},
+		{name: "bit 53", bitfield: "9007199254740992", want: []string{"UNKNOWN_BIT_53"}},
+		{name: "bit 53 beside bit 0", bitfield: "9007199254740993", want: []string{"CREATE_INSTANT_INVITE", "UNKNOWN_BIT_53"}},
+		{name: "beyond 64 bits", bitfield: "1180591620717411303432", want: []string{"ADMINISTRATOR", "UNKNOWN_BIT_70"}},
+		{name: "negative", bitfield: "-8", wantError: true},
+		{name: "not a number", bitfield: "ADMINISTRATOR", wantError: true},
+		{name: "float", bitfield: "8.0", wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := PermissionNames(test.bitfield)
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if !test.wantError && !slices.Equal(got, test.want) {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPermissionRoundTrip(t *testing.T) {
+	names := []string{"UNKNOWN_BIT_60"}
+	for name := range Permissions {
+		names = append(names, name)
+	}
+
+	bitfield, err := PermissionBitfield(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	decoded, err := PermissionNames(bitfield)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	again, err := PermissionBitfield(decoded)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	slices.Sort(names)
+	sorted := slices.Clone(decoded)
+	slices.Sort(sorted)
+	if !slices.Equal(names, sorted) {
+		t.Errorf("expected names %v, got %v", names, decoded)
+	}
+	if again != bitfield {
+		t.Errorf("expected bitfield %s, got %s", bitfield, again)
+	}
+}
@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ApplicationRoleConnectionMetadataType int
+
+const (
+	ApplicationRoleConnectionMetadataTypeIntegerLessThanOrEqual     ApplicationRoleConnectionMetadataType = 1
+	ApplicationRoleConnectionMetadataTypeIntegerGreaterThanOrEqual  ApplicationRoleConnectionMetadataType = 2
+	ApplicationRoleConnectionMetadataTypeIntegerEqual               ApplicationRoleConnectionMetadataType = 3
+	ApplicationRoleConnectionMetadataTypeIntegerNotEqual            ApplicationRoleConnectionMetadataType = 4
+	ApplicationRoleConnectionMetadataTypeDatetimeLessThanOrEqual    ApplicationRoleConnectionMetadataType = 5
+	ApplicationRoleConnectionMetadataTypeDatetimeGreaterThanOrEqual ApplicationRoleConnectionMetadataType = 6
+	ApplicationRoleConnectionMetadataTypeBooleanEqual               ApplicationRoleConnectionMetadataType = 7
+	ApplicationRoleConnectionMetadataTypeBooleanNotEqual            ApplicationRoleConnectionMetadataType = 8
+)
+
+// MaxRoleConnectionMetadataRecords is the number of metadata records Discord allows per application.
+const MaxRoleConnectionMetadataRecords = 5
+
+type ApplicationRoleConnectionMetadata struct {
+	Type                     ApplicationRoleConnectionMetadataType `json:"type"`
+	Key                      string                                `json:"key"`
+	Name                     string                                `json:"name"`
+	NameLocalizations        *map[string]string                    `json:"name_localizations,omitempty"`
+	Description              string                                `json:"description"`
+	DescriptionLocalizations *map[string]string                    `json:"description_localizations,omitempty"`
+}
+
+// GetRoleConnectionMetadata fetches the role connection metadata records of the application.
+func (c *Client) GetRoleConnectionMetadata(ctx context.Context, applicationID string) (output *[]ApplicationRoleConnectionMetadata, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/role-connections/metadata", applicationID), nil, &output)
+	return output, resp, err
+}
+
+// UpdateRoleConnectionMetadata replaces every role connection metadata record of the application.
+func (c *Client) UpdateRoleConnectionMetadata(ctx context.Context, applicationID string, request []ApplicationRoleConnectionMetadata) (output *[]ApplicationRoleConnectionMetadata, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPut, fmt.Sprintf("/applications/%s/role-connections/metadata", applicationID), request, &output)
+	return output, resp, err
+}
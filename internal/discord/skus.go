@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type SKUType int
+
+const (
+	SKUTypeDurable           SKUType = 2
+	SKUTypeConsumable        SKUType = 3
+	SKUTypeSubscription      SKUType = 5
+	SKUTypeSubscriptionGroup SKUType = 6
+)
+
+const (
+	SKUFlagAvailable         = 1 << 2
+	SKUFlagGuildSubscription = 1 << 7
+	SKUFlagUserSubscription  = 1 << 8
+)
+
+// SKUFlags maps the names of the SKU flags to their values.
+var SKUFlags = map[string]int{
+	"AVAILABLE":          SKUFlagAvailable,
+	"GUILD_SUBSCRIPTION": SKUFlagGuildSubscription,
+	"USER_SUBSCRIPTION":  SKUFlagUserSubscription,
+}
+
+type SKU struct {
+	ID            string  `json:"id"`
+	Type          SKUType `json:"type"`
+	ApplicationID string  `json:"application_id"`
+	Name          string  `json:"name"`
+	Slug          string  `json:"slug"`
+	Flags         int     `json:"flags"`
+}
+
+// GetSKUs fetches every SKU of the application.
+func (c *Client) GetSKUs(ctx context.Context, applicationID string) (output *[]SKU, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, fmt.Sprintf("/applications/%s/skus", applicationID), nil, &output)
+	return output, resp, err
+}
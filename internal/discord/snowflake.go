@@ -0,0 +1,33 @@
+package discord
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DiscordEpoch is the first millisecond of 2015, the point in time snowflake timestamps are measured from.
+const DiscordEpoch int64 = 1420070400000
+
+// Snowflake holds the components Discord packs into every 64-bit ID.
+type Snowflake struct {
+	Timestamp time.Time
+	WorkerID  uint64
+	ProcessID uint64
+	Increment uint64
+}
+
+// ParseSnowflake decodes the decimal string form of a Discord ID.
+func ParseSnowflake(id string) (*Snowflake, error) {
+	value, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a Discord snowflake, expected an unsigned 64-bit decimal integer", id)
+	}
+
+	return &Snowflake{
+		Timestamp: time.UnixMilli(int64(value>>22) + DiscordEpoch).UTC(),
+		WorkerID:  (value >> 17) & 0x1f,
+		ProcessID: (value >> 12) & 0x1f,
+		Increment: value & 0xfff,
+	}, nil
+}
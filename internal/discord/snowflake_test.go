@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSnowflake(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		want      Snowflake
+		wantError bool
+	}{
+		{
+			// The example snowflake from the Discord API reference
+			name: "documented example",
+			id:   "175928847299117063",
+			want: Snowflake{
+				Timestamp: time.Date(2016, 4, 30, 11, 18, 25, 796*int(time.Millisecond), time.UTC),
+				WorkerID:  1,
+				ProcessID: 0,
+				Increment: 7,
+			},
+		},
+		{
+			name: "zero",
+			id:   "0",
+			want: Snowflake{Timestamp: time.UnixMilli(DiscordEpoch).UTC()},
+		},
+		{
+			name: "largest",
+			id:   "18446744073709551615",
+			want: Snowflake{
+				Timestamp: time.UnixMilli(int64(uint64(1)<<42-1) + DiscordEpoch).UTC(),
+				WorkerID:  31,
+				ProcessID: 31,
+				Increment: 4095,
+			},
+		},
+		{name: "overflow", id: "18446744073709551616", wantError: true},
+		{name: "negative", id: "-1", wantError: true},
+		{name: "empty", id: "", wantError: true},
+		{name: "letters", id: "12345abc", wantError: true},
+		{name: "whitespace", id: " 175928847299117063", wantError: true},
+		{name: "signed", id: "+175928847299117063", wantError: true},
+		{name: "decimal", id: "1.5", wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseSnowflake(test.id)
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if test.wantError {
+				return
+			}
+			if !got.Timestamp.Equal(test.want.Timestamp) || got.WorkerID != test.want.WorkerID ||
+				got.ProcessID != test.want.ProcessID || got.Increment != test.want.Increment {
+				t.Errorf("expected %+v, got %+v", test.want, *got)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type SubscriptionStatus int
+
+const (
+	SubscriptionStatusActive   SubscriptionStatus = 0
+	SubscriptionStatusEnding   SubscriptionStatus = 1
+	SubscriptionStatusInactive SubscriptionStatus = 2
+)
+
+type Subscription struct {
+	ID                 string             `json:"id"`
+	UserID             string             `json:"user_id"`
+	SKUIDs             []string           `json:"sku_ids"`
+	EntitlementIDs     []string           `json:"entitlement_ids"`
+	RenewalSKUIDs      *[]string          `json:"renewal_sku_ids,omitempty"`
+	CurrentPeriodStart string             `json:"current_period_start"`
+	CurrentPeriodEnd   string             `json:"current_period_end"`
+	Status             SubscriptionStatus `json:"status"`
+	CanceledAt         *string            `json:"canceled_at,omitempty"`
+}
+
+// ListSKUSubscriptionsParams filters the subscriptions returned by ListSKUSubscriptions. Empty values are left out of
+// the query. Discord requires UserID when the subscriptions are listed with a bot token.
+type ListSKUSubscriptionsParams struct {
+	Page
+	UserID string
+}
+
+func (p *ListSKUSubscriptionsParams) query() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if p.UserID != "" {
+		query.Set("user_id", p.UserID)
+	}
+	p.Page.setQuery(query)
+	return query
+}
+
+// ListSKUSubscriptions fetches a page of the subscriptions to a SKU matching params.
+func (c *Client) ListSKUSubscriptions(ctx context.Context, skuID string, params *ListSKUSubscriptionsParams) (output *[]Subscription, resp *http.Response, err error) {
+	path := fmt.Sprintf("/skus/%s/subscriptions", skuID)
+	if query := params.query(); len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, path, nil, &output)
+	return output, resp, err
+}
+
+// ListAllSKUSubscriptions follows the pages of the subscriptions to a SKU matching params, collecting at most
+// maxResults subscriptions.
+func (c *Client) ListAllSKUSubscriptions(ctx context.Context, skuID string, params ListSKUSubscriptionsParams, maxResults int) ([]Subscription, *http.Response, error) {
+	return ListPages(ctx, params.Page, maxResults, func(ctx context.Context, page Page) ([]Subscription, *http.Response, error) {
+		params.Page = page
+		subscriptions, resp, err := c.ListSKUSubscriptions(ctx, skuID, &params)
+		if subscriptions == nil {
+			return nil, resp, err
+		}
+		return *subscriptions, resp, err
+	}, func(subscription Subscription) string {
+		return subscription.ID
+	})
+}
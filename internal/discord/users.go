@@ -0,0 +1,42 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+)
+
+// User is the partial user object Discord embeds in other resources.
+type User struct {
+	ID            string  `json:"id"`
+	Username      string  `json:"username"`
+	Discriminator string  `json:"discriminator,omitempty"`
+	GlobalName    *string `json:"global_name,omitempty"`
+	Avatar        *string `json:"avatar,omitempty"`
+	Banner        *string `json:"banner,omitempty"`
+	Bot           *bool   `json:"bot,omitempty"`
+}
+
+// Team is the developer team which owns an application.
+type Team struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	OwnerUserID string `json:"owner_user_id"`
+}
+
+type EditCurrentUser struct {
+	Username *string `json:"username,omitempty"`
+	Avatar   *string `json:"avatar,omitempty"` // image data URI
+	Banner   *string `json:"banner,omitempty"` // image data URI
+}
+
+// GetCurrentUser fetches the bot user the token belongs to.
+func (c *Client) GetCurrentUser(ctx context.Context) (output *User, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodGet, "/users/@me", nil, &output)
+	return output, resp, err
+}
+
+// EditCurrentUser edits the profile of the bot user the token belongs to.
+func (c *Client) EditCurrentUser(ctx context.Context, request *EditCurrentUser) (output *User, resp *http.Response, err error) {
+	resp, err = c.do(ctx, tokenTypeBot, http.MethodPatch, "/users/@me", request, &output)
+	return output, resp, err
+}
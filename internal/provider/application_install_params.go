@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"sort"
+)
+
+const (
+	installScopeBot                 = "bot"
+	installScopeApplicationCommands = "applications.commands"
+)
+
+var installParamsAttrTypes = map[string]attr.Type{
+	"scopes":      types.SetType{ElemType: types.StringType},
+	"permissions": types.SetType{ElemType: types.StringType},
+}
+
+var integrationTypesConfigAttrTypes = map[string]attr.Type{
+	"guild_install": types.ObjectType{AttrTypes: installParamsAttrTypes},
+	"user_install":  types.ObjectType{AttrTypes: installParamsAttrTypes},
+}
+
+type installParamsModel struct {
+	Scopes      types.Set `tfsdk:"scopes"`
+	Permissions types.Set `tfsdk:"permissions"`
+}
+
+type integrationTypesConfigModel struct {
+	GuildInstall types.Object `tfsdk:"guild_install"`
+	UserInstall  types.Object `tfsdk:"user_install"`
+}
+
+// installParamsAttributes builds the scopes and permissions attributes shared by every install params block.
+// Integration type blocks may be empty, which supports the context without any in-app install params.
+func installParamsAttributes(scopesRequired bool) map[string]schema.Attribute {
+	permissionNames := make([]string, 0, len(discord.Permissions))
+	for name := range discord.Permissions {
+		permissionNames = append(permissionNames, name)
+	}
+	sort.Strings(permissionNames)
+
+	return map[string]schema.Attribute{
+		"scopes": schema.SetAttribute{
+			MarkdownDescription: "The OAuth2 scopes requested on install - `bot` and/or `applications.commands`",
+			ElementType:         types.StringType,
+			Required:            scopesRequired,
+			Optional:            !scopesRequired,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(installScopeBot, installScopeApplicationCommands)),
+			},
+		},
+		"permissions": schema.SetAttribute{
+			MarkdownDescription: "The names of the permissions requested for the bot, such as `SEND_MESSAGES`. Requires the `bot` scope",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.Any(
+					stringvalidator.OneOf(permissionNames...),
+					stringvalidator.RegexMatches(discord.UnknownPermissionPattern, "must be a known permission name or UNKNOWN_BIT_<position>"),
+				)),
+			},
+		},
+	}
+}
+
+func installParamsBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "The default in-app install link settings. When omitted the install params are left unmanaged",
+		Attributes:          installParamsAttributes(true),
+	}
+}
+
+func integrationTypesConfigBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "The installation contexts the application supports. A context is supported when its block is present, " +
+			"optionally with the install params of its in-app install link. When omitted the installation contexts are left unmanaged",
+		Blocks: map[string]schema.Block{
+			"guild_install": schema.SingleNestedBlock{
+				Description: "Installation to a guild",
+				Attributes:  installParamsAttributes(false),
+			},
+			"user_install": schema.SingleNestedBlock{
+				Description: "Installation to a user",
+				Attributes:  installParamsAttributes(false),
+			},
+		},
+	}
+}
+
+// installParamsFromObject converts an install params block to the API model, returning nil for a block without scopes.
+func installParamsFromObject(ctx context.Context, value types.Object) (*discord.InstallParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	var model installParamsModel
+	diags.Append(value.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || model.Scopes.IsNull() || model.Scopes.IsUnknown() {
+		return nil, diags
+	}
+
+	installParams := &discord.InstallParams{Scopes: []string{}, Permissions: "0"}
+	diags.Append(model.Scopes.ElementsAs(ctx, &installParams.Scopes, false)...)
+	sort.Strings(installParams.Scopes)
+
+	if !model.Permissions.IsNull() && !model.Permissions.IsUnknown() {
+		var names []string
+		diags.Append(model.Permissions.ElementsAs(ctx, &names, false)...)
+		bitfield, err := discord.PermissionBitfield(names)
+		if err != nil {
+			diags.AddError("Invalid Install Permissions", err.Error())
+			return nil, diags
+		}
+		installParams.Permissions = bitfield
+	}
+
+	return installParams, diags
+}
+
+// installParamsToObject converts install params returned by discord to a block value. Discord reports no permissions as
+// "0", which is tracked as a null set unless the prior value held an empty set.
+func installParamsToObject(ctx context.Context, installParams *discord.InstallParams, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := installParamsModel{
+		Scopes:      types.SetNull(types.StringType),
+		Permissions: types.SetNull(types.StringType),
+	}
+
+	var priorModel installParamsModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{})...)
+	}
+
+	if installParams != nil {
+		var setDiags diag.Diagnostics
+		model.Scopes, setDiags = types.SetValueFrom(ctx, types.StringType, installParams.Scopes)
+		diags.Append(setDiags...)
+
+		names := []string{}
+		if installParams.Permissions != "" {
+			var err error
+			names, err = discord.PermissionNames(installParams.Permissions)
+			if err != nil {
+				diags.AddWarning("Unknown Install Permissions", "Could not decode the install permissions returned by discord: "+err.Error())
+				names = []string{}
+			}
+		}
+		if len(names) > 0 || (!priorModel.Permissions.IsNull() && !priorModel.Permissions.IsUnknown()) {
+			model.Permissions, setDiags = types.SetValueFrom(ctx, types.StringType, names)
+			diags.Append(setDiags...)
+		}
+	}
+
+	object, objectDiags := types.ObjectValueFrom(ctx, installParamsAttrTypes, model)
+	diags.Append(objectDiags...)
+	return object, diags
+}
+
+// integrationTypesConfigFromObject converts the integration_types_config block to the API model.
+func integrationTypesConfigFromObject(ctx context.Context, value types.Object) (*map[string]discord.ApplicationIntegrationTypeConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	var model integrationTypesConfigModel
+	diags.Append(value.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	config := map[string]discord.ApplicationIntegrationTypeConfig{}
+	for key, block := range map[string]types.Object{
+		discord.ApplicationIntegrationTypeGuildInstall: model.GuildInstall,
+		discord.ApplicationIntegrationTypeUserInstall:  model.UserInstall,
+	} {
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+		installParams, installDiags := installParamsFromObject(ctx, block)
+		diags.Append(installDiags...)
+		config[key] = discord.ApplicationIntegrationTypeConfig{OAuth2InstallParams: installParams}
+	}
+
+	return &config, diags
+}
+
+// integrationTypesConfigToObject converts the integration_types_config returned by discord to a block value.
+func integrationTypesConfigToObject(ctx context.Context, config *map[string]discord.ApplicationIntegrationTypeConfig, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorModel integrationTypesConfigModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{})...)
+	}
+
+	model := integrationTypesConfigModel{
+		GuildInstall: types.ObjectNull(installParamsAttrTypes),
+		UserInstall:  types.ObjectNull(installParamsAttrTypes),
+	}
+	if config != nil {
+		if typeConfig, ok := (*config)[discord.ApplicationIntegrationTypeGuildInstall]; ok {
+			var objectDiags diag.Diagnostics
+			model.GuildInstall, objectDiags = installParamsToObject(ctx, typeConfig.OAuth2InstallParams, priorModel.GuildInstall)
+			diags.Append(objectDiags...)
+		}
+		if typeConfig, ok := (*config)[discord.ApplicationIntegrationTypeUserInstall]; ok {
+			var objectDiags diag.Diagnostics
+			model.UserInstall, objectDiags = installParamsToObject(ctx, typeConfig.OAuth2InstallParams, priorModel.UserInstall)
+			diags.Append(objectDiags...)
+		}
+	}
+
+	object, objectDiags := types.ObjectValueFrom(ctx, integrationTypesConfigAttrTypes, model)
+	diags.Append(objectDiags...)
+	return object, diags
+}
+
+// validateInstallParams checks that bot permissions are only requested alongside the bot scope, and that user
+// installs only request the scopes available to them.
+func validateInstallParams(ctx context.Context, value types.Object, blockPath path.Path, userInstall bool, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	var model installParamsModel
+	diags.Append(value.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || model.Scopes.IsUnknown() || model.Permissions.IsUnknown() {
+		return
+	}
+
+	var scopes []string
+	if !model.Scopes.IsNull() {
+		diags.Append(model.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+	hasBot := false
+	for _, scope := range scopes {
+		if scope == installScopeBot {
+			hasBot = true
+		}
+	}
+
+	if userInstall && hasBot {
+		diags.AddAttributeError(
+			blockPath.AtName("scopes"),
+			"Invalid Install Scope",
+			"User installs only support the applications.commands scope",
+		)
+	}
+
+	if !model.Permissions.IsNull() && len(model.Permissions.Elements()) > 0 && !hasBot {
+		diags.AddAttributeError(
+			blockPath.AtName("permissions"),
+			"Invalid Install Permissions",
+			"Permissions can only be requested alongside the bot scope",
+		)
+	}
+}
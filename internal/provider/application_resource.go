@@ -0,0 +1,560 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+)
+
+var (
+	_ resource.Resource                   = &applicationResource{}
+	_ resource.ResourceWithValidateConfig = &applicationResource{}
+	_ resource.ResourceWithConfigure      = &applicationResource{}
+	_ resource.ResourceWithImportState    = &applicationResource{}
+	_ resource.ResourceWithModifyPlan     = &applicationResource{}
+)
+
+const maxApplicationDescriptionLength = 400
+
+// applicationEventWebhookStatuses maps the event webhook status names used in configuration to Discord's numeric statuses.
+var applicationEventWebhookStatuses = map[string]discord.ApplicationEventWebhookStatus{
+	"disabled":            discord.ApplicationEventWebhookStatusDisabled,
+	"enabled":             discord.ApplicationEventWebhookStatusEnabled,
+	"disabled_by_discord": discord.ApplicationEventWebhookStatusDisabledByDiscord,
+}
+
+// applicationImageAttributes are the prefixes of the icon and cover image file attributes.
+var applicationImageAttributes = []string{"icon", "cover_image"}
+
+// httpsURLPattern matches an https URL or the empty string, which clears the URL in discord.
+var httpsURLPattern = regexp.MustCompile(`^(https://\S+)?$`)
+
+func NewApplicationResource() resource.Resource {
+	return &applicationResource{}
+}
+
+type applicationResourceModel struct {
+	ID                             SnowflakeValue `tfsdk:"id"`
+	Name                           types.String   `tfsdk:"name"`
+	Description                    types.String   `tfsdk:"description"`
+	InteractionsEndpointURL        types.String   `tfsdk:"interactions_endpoint_url"`
+	RoleConnectionsVerificationURL types.String   `tfsdk:"role_connections_verification_url"`
+	CustomInstallURL               types.String   `tfsdk:"custom_install_url"`
+	EventWebhooksURL               types.String   `tfsdk:"event_webhooks_url"`
+	EventWebhooksStatus            types.String   `tfsdk:"event_webhooks_status"`
+	EventWebhooksTypes             types.Set      `tfsdk:"event_webhooks_types"`
+	IconFile                       types.String   `tfsdk:"icon_file"`
+	IconFileHash                   types.String   `tfsdk:"icon_file_hash"`
+	IconHash                       types.String   `tfsdk:"icon_hash"`
+	CoverImageFile                 types.String   `tfsdk:"cover_image_file"`
+	CoverImageFileHash             types.String   `tfsdk:"cover_image_file_hash"`
+	CoverImageHash                 types.String   `tfsdk:"cover_image_hash"`
+	Tags                           types.Set      `tfsdk:"tags"`
+	Flags                          types.Set      `tfsdk:"flags"`
+	InstallParams                  types.Object   `tfsdk:"install_params"`
+	IntegrationTypesConfig         types.Object   `tfsdk:"integration_types_config"`
+	LastUpdated                    types.String   `tfsdk:"last_updated"`
+}
+
+// toRequest builds an edit request holding the known values of the model which differ from the prior state. Fields are
+// only sent when they change, as discord re-validates some of them on every edit, such as the interactions endpoint URL
+// which it PINGs whenever the field is present.
+func (a *applicationResourceModel) toRequest(ctx context.Context, prior *applicationResourceModel) (*discord.EditApplication, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &discord.EditApplication{
+		Description:                    changedStringPointer(a.Description, prior.Description),
+		InteractionsEndpointURL:        changedStringPointer(a.InteractionsEndpointURL, prior.InteractionsEndpointURL),
+		RoleConnectionsVerificationURL: changedStringPointer(a.RoleConnectionsVerificationURL, prior.RoleConnectionsVerificationURL),
+		CustomInstallURL:               changedStringPointer(a.CustomInstallURL, prior.CustomInstallURL),
+		EventWebhooksURL:               changedStringPointer(a.EventWebhooksURL, prior.EventWebhooksURL),
+	}
+
+	if !a.EventWebhooksStatus.IsNull() && !a.EventWebhooksStatus.IsUnknown() && !a.EventWebhooksStatus.Equal(prior.EventWebhooksStatus) {
+		status := applicationEventWebhookStatuses[a.EventWebhooksStatus.ValueString()]
+		request.EventWebhooksStatus = &status
+	}
+
+	if !a.EventWebhooksTypes.IsNull() && !a.EventWebhooksTypes.IsUnknown() && !a.EventWebhooksTypes.Equal(prior.EventWebhooksTypes) {
+		eventTypes := []string{}
+		diags.Append(a.EventWebhooksTypes.ElementsAs(ctx, &eventTypes, false)...)
+		sort.Strings(eventTypes)
+		request.EventWebhooksTypes = &eventTypes
+	}
+
+	if !a.Tags.IsNull() && !a.Tags.IsUnknown() && !a.Tags.Equal(prior.Tags) {
+		tags := []string{}
+		diags.Append(a.Tags.ElementsAs(ctx, &tags, false)...)
+		request.Tags = &tags
+	}
+
+	if !a.Flags.IsNull() && !a.Flags.IsUnknown() && !a.Flags.Equal(prior.Flags) {
+		var names []string
+		diags.Append(a.Flags.ElementsAs(ctx, &names, false)...)
+		flags := 0
+		for _, name := range names {
+			flags |= discord.EditableApplicationFlags[name]
+		}
+		request.Flags = &flags
+	}
+
+	var objectDiags diag.Diagnostics
+	if !a.InstallParams.Equal(prior.InstallParams) {
+		request.InstallParams, objectDiags = installParamsFromObject(ctx, a.InstallParams)
+		diags.Append(objectDiags...)
+	}
+	if !a.IntegrationTypesConfig.Equal(prior.IntegrationTypesConfig) {
+		request.IntegrationTypesConfig, objectDiags = integrationTypesConfigFromObject(ctx, a.IntegrationTypesConfig)
+		diags.Append(objectDiags...)
+	}
+
+	return request, diags
+}
+
+// changedStringPointer returns the known value when it differs from the prior value, and nil otherwise.
+func changedStringPointer(value, prior types.String) *string {
+	if value.Equal(prior) {
+		return nil
+	}
+	return knownStringPointer(value)
+}
+
+func (a *applicationResourceModel) fromApplication(ctx context.Context, application *discord.Application) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	a.ID = NewSnowflakeValue(application.ID)
+	a.Name = types.StringValue(application.Name)
+	a.Description = types.StringValue(application.Description)
+	a.InteractionsEndpointURL = types.StringPointerValue(application.InteractionsEndpointURL)
+	a.RoleConnectionsVerificationURL = types.StringPointerValue(application.RoleConnectionsVerificationURL)
+	a.CustomInstallURL = types.StringPointerValue(application.CustomInstallURL)
+	a.EventWebhooksURL = types.StringPointerValue(application.EventWebhooksURL)
+
+	a.IconHash = types.StringPointerValue(application.Icon)
+	a.CoverImageHash = types.StringPointerValue(application.CoverImage)
+
+	// Discord omits cleared values, which are tracked as empty strings so clearing a URL does not show as drift
+	for _, value := range []*types.String{&a.InteractionsEndpointURL, &a.RoleConnectionsVerificationURL, &a.CustomInstallURL, &a.EventWebhooksURL} {
+		if value.IsNull() {
+			*value = types.StringValue("")
+		}
+	}
+
+	// Applications which never configured event webhooks omit the status, which is equivalent to disabled
+	a.EventWebhooksStatus = types.StringValue("disabled")
+	for name, status := range applicationEventWebhookStatuses {
+		if application.EventWebhooksStatus == status {
+			a.EventWebhooksStatus = types.StringValue(name)
+		}
+	}
+
+	eventTypes := []string{}
+	if application.EventWebhooksTypes != nil {
+		eventTypes = *application.EventWebhooksTypes
+	}
+	var setDiags diag.Diagnostics
+	a.EventWebhooksTypes, setDiags = types.SetValueFrom(ctx, types.StringType, eventTypes)
+	diags.Append(setDiags...)
+
+	tags := []string{}
+	if application.Tags != nil {
+		tags = *application.Tags
+	}
+	a.Tags, setDiags = types.SetValueFrom(ctx, types.StringType, tags)
+	diags.Append(setDiags...)
+
+	flags := []string{}
+	for name, flag := range discord.EditableApplicationFlags {
+		if application.Flags&flag != 0 {
+			flags = append(flags, name)
+		}
+	}
+	sort.Strings(flags)
+	a.Flags, setDiags = types.SetValueFrom(ctx, types.StringType, flags)
+	diags.Append(setDiags...)
+
+	// The install blocks are only tracked once configured, as blocks cannot be computed
+	var objectDiags diag.Diagnostics
+	if !a.InstallParams.IsNull() {
+		a.InstallParams, objectDiags = installParamsToObject(ctx, application.InstallParams, a.InstallParams)
+		diags.Append(objectDiags...)
+	}
+	if !a.IntegrationTypesConfig.IsNull() {
+		a.IntegrationTypesConfig, objectDiags = integrationTypesConfigToObject(ctx, application.IntegrationTypesConfig, a.IntegrationTypesConfig)
+		diags.Append(objectDiags...)
+	}
+
+	a.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	return diags
+}
+
+type applicationResource struct {
+	client *discord.Client
+}
+
+func (a *applicationResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "discord-application_application"
+}
+
+func (a *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	flagNames := make([]string, 0, len(discord.EditableApplicationFlags))
+	for name := range discord.EditableApplicationFlags {
+		flagNames = append(flagNames, name)
+	}
+	sort.Strings(flagNames)
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Settings of the Discord application the provider token belongs to. " +
+			"The application always exists, so creating this resource adopts it and destroying it only removes it from state. " +
+			"Attributes left out of the configuration keep their current value in discord.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the application",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: fmt.Sprintf("The description of the application, at most %d characters", maxApplicationDescriptionLength),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(maxApplicationDescriptionLength),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interactions_endpoint_url":         applicationURLAttribute("The https URL discord sends interactions to instead of the gateway. Set to an empty string to clear"),
+			"role_connections_verification_url": applicationURLAttribute("The https URL users are sent to when linking roles to the application. Set to an empty string to clear"),
+			"custom_install_url":                applicationURLAttribute("The https URL of the application's custom install link. Set to an empty string to clear"),
+			"event_webhooks_url":                applicationURLAttribute("The https URL discord sends event webhooks to. Set to an empty string to clear"),
+			"event_webhooks_status": schema.StringAttribute{
+				MarkdownDescription: "Whether discord sends event webhooks - `enabled` or `disabled`. " +
+					"Discord reports `disabled_by_discord` when it disabled the webhooks itself, which is re-enabled on the next apply " +
+					"when configured as `enabled`",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "disabled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_webhooks_types": schema.SetAttribute{
+				MarkdownDescription: "The event types sent to the event webhooks URL, such as `APPLICATION_AUTHORIZED` and `ENTITLEMENT_CREATE`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(discord.WebhookEventTypes...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"icon_file":             imageFileAttribute("icon"),
+			"icon_file_hash":        imageFileHashAttribute("icon"),
+			"icon_hash":             imageHashAttribute("icon"),
+			"cover_image_file":      imageFileAttribute("cover image"),
+			"cover_image_file_hash": imageFileHashAttribute("cover image"),
+			"cover_image_hash":      imageHashAttribute("cover image"),
+			"tags": schema.SetAttribute{
+				Description: "Tags describing the application, at most 5 of up to 20 characters each",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(5),
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 20)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flags": schema.SetAttribute{
+				MarkdownDescription: "The application flags to set. Only the limited gateway intent flags can be set by the application: " +
+					"`GATEWAY_PRESENCE_LIMITED`, `GATEWAY_GUILD_MEMBERS_LIMITED` and `GATEWAY_MESSAGE_CONTENT_LIMITED`",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(flagNames...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the application was updated",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"install_params":           installParamsBlock(),
+			"integration_types_config": integrationTypesConfigBlock(),
+		},
+	}
+}
+
+func applicationURLAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(httpsURLPattern, "must be an https URL or an empty string"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (a *applicationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config applicationResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A URL left out of the configuration keeps its current value, so only an explicitly cleared URL conflicts
+	if config.EventWebhooksStatus.ValueString() == "enabled" && !config.EventWebhooksURL.IsNull() &&
+		!config.EventWebhooksURL.IsUnknown() && config.EventWebhooksURL.ValueString() == "" {
+		response.Diagnostics.AddAttributeError(
+			path.Root("event_webhooks_url"),
+			"Missing Event Webhooks URL",
+			"Event webhooks cannot be enabled without an event webhooks URL",
+		)
+	}
+
+	validateInstallParams(ctx, config.InstallParams, path.Root("install_params"), false, &response.Diagnostics)
+
+	if config.IntegrationTypesConfig.IsNull() || config.IntegrationTypesConfig.IsUnknown() {
+		return
+	}
+
+	var integrationTypesConfig integrationTypesConfigModel
+	diags = config.IntegrationTypesConfig.As(ctx, &integrationTypesConfig, basetypes.ObjectAsOptions{})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateInstallParams(ctx, integrationTypesConfig.GuildInstall, path.Root("integration_types_config").AtName("guild_install"), false, &response.Diagnostics)
+	validateInstallParams(ctx, integrationTypesConfig.UserInstall, path.Root("integration_types_config").AtName("user_install"), true, &response.Diagnostics)
+}
+
+// ModifyPlan hashes the content of the image files so that changing a file, not only its path, uploads it again.
+func (a *applicationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	planImageFiles(ctx, request, response, applicationImageAttributes)
+}
+
+func (a *applicationResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = providerData.api
+}
+
+func (a *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply edits the application with the values of the model which differ from the prior state, which is nil when the
+// resource is created, and refreshes the model from the response.
+func (a *applicationResource) apply(ctx context.Context, model *applicationResourceModel, prior *applicationResourceModel, diags *diag.Diagnostics) {
+	if prior == nil {
+		prior = &applicationResourceModel{}
+	}
+
+	editApplication, requestDiags := model.toRequest(ctx, prior)
+	diags.Append(requestDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	editApplication.Icon = imageFileUpload(model.IconFile, &model.IconFileHash, prior.IconFileHash, path.Root("icon_file"), diags)
+	editApplication.CoverImage = imageFileUpload(model.CoverImageFile, &model.CoverImageFileHash, prior.CoverImageFileHash, path.Root("cover_image_file"), diags)
+	if diags.HasError() {
+		return
+	}
+
+	application, apiResponse, err := a.client.EditCurrentApplication(ctx, editApplication)
+	if err != nil {
+		diags.AddError(
+			"Error Editing Discord Application",
+			"Could not edit application, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Error Editing Discord Application",
+			fmt.Sprintf("Could not edit application, unexpected status code: %d", apiResponse.StatusCode),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags.Append(model.fromApplication(ctx, application)...)
+}
+
+func (a *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan applicationResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The application already exists, so creating the resource applies the configured settings to it
+	a.apply(ctx, &plan, nil, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state applicationResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed application value from discord
+	application, apiResponse, err := a.client.GetCurrentApplication(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application",
+			"Could not read Discord Application | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application",
+			"Could not read Discord Application: "+apiResponse.Status,
+		)
+		return
+	}
+
+	if !state.ID.IsNull() && !state.ID.IsUnknown() && state.ID.ValueString() != application.ID {
+		response.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Mismatched Discord Application",
+			fmt.Sprintf("The resource tracks application ID %s but the provider token belongs to application ID %s. "+
+				"The application resource can only manage the application of the configured token", state.ID.ValueString(), application.ID),
+		)
+		return
+	}
+
+	// An image changed outside of terraform no longer matches the local file, so its hash is cleared to upload the file again
+	if imageFileDrifted(state.IconHash, application.Icon) {
+		state.IconFileHash = types.StringNull()
+	}
+	if imageFileDrifted(state.CoverImageHash, application.CoverImage) {
+		state.CoverImageFileHash = types.StringNull()
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromApplication(ctx, application)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan applicationResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state applicationResourceModel
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	a.apply(ctx, &plan, &state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Applications cannot be deleted through the API, so the application is left as it is and only removed from state
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"time"
+)
+
+var (
+	_ resource.Resource                = &botUserResource{}
+	_ resource.ResourceWithConfigure   = &botUserResource{}
+	_ resource.ResourceWithImportState = &botUserResource{}
+	_ resource.ResourceWithModifyPlan  = &botUserResource{}
+)
+
+// botUserImageAttributes are the prefixes of the avatar and banner file attributes.
+var botUserImageAttributes = []string{"avatar", "banner"}
+
+func NewBotUserResource() resource.Resource {
+	return &botUserResource{}
+}
+
+type botUserResourceModel struct {
+	ID             SnowflakeValue `tfsdk:"id"`
+	Username       types.String   `tfsdk:"username"`
+	AvatarFile     types.String   `tfsdk:"avatar_file"`
+	AvatarFileHash types.String   `tfsdk:"avatar_file_hash"`
+	AvatarHash     types.String   `tfsdk:"avatar_hash"`
+	BannerFile     types.String   `tfsdk:"banner_file"`
+	BannerFileHash types.String   `tfsdk:"banner_file_hash"`
+	BannerHash     types.String   `tfsdk:"banner_hash"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+}
+
+func (b *botUserResourceModel) fromUser(user *discord.User) {
+	b.ID = NewSnowflakeValue(user.ID)
+	b.Username = types.StringValue(user.Username)
+	b.AvatarHash = types.StringPointerValue(user.Avatar)
+	b.BannerHash = types.StringPointerValue(user.Banner)
+	b.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+}
+
+type botUserResource struct {
+	client *discord.Client
+}
+
+func (b *botUserResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "discord-application_bot_user"
+}
+
+func (b *botUserResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The profile of the bot user the provider token belongs to. " +
+			"The bot user always exists, so creating this resource adopts it and destroying it only removes it from state. " +
+			"Attributes left out of the configuration keep their current value in discord.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the bot user",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the bot, 2 to 32 characters. Discord heavily rate limits username changes",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"avatar_file":      imageFileAttribute("avatar"),
+			"avatar_file_hash": imageFileHashAttribute("avatar"),
+			"avatar_hash":      imageHashAttribute("avatar"),
+			"banner_file":      imageFileAttribute("banner"),
+			"banner_file_hash": imageFileHashAttribute("banner"),
+			"banner_hash":      imageHashAttribute("banner"),
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the bot user was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan hashes the content of the image files and warns about pending username changes, which discord only
+// allows a couple of times per hour.
+func (b *botUserResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	planImageFiles(ctx, request, response, botUserImageAttributes)
+
+	var plan botUserResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() || plan.Username.IsNull() || plan.Username.IsUnknown() {
+		return
+	}
+
+	// Creating the resource adopts the existing bot user, so its current username is compared instead of the state
+	var priorUsername string
+	if request.State.Raw.IsNull() {
+		if b.client == nil {
+			return
+		}
+		var currentDiags diag.Diagnostics
+		current := b.currentUser(ctx, &currentDiags)
+		if currentDiags.HasError() {
+			return
+		}
+		priorUsername = current.Username.ValueString()
+	} else {
+		var state botUserResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		priorUsername = state.Username.ValueString()
+	}
+
+	if plan.Username.ValueString() != priorUsername {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root("username"),
+			"Pending Bot Username Change",
+			fmt.Sprintf("The bot will be renamed from %q to %q. Discord heavily rate limits username changes, "+
+				"so repeated renames may fail until the rate limit resets", priorUsername, plan.Username.ValueString()),
+		)
+	}
+}
+
+func (b *botUserResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	b.client = providerData.api
+}
+
+func (b *botUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply edits the bot user with the values of the model which differ from the prior state, as unchanged usernames
+// still count towards the rename rate limit. The model is refreshed from the response.
+func (b *botUserResource) apply(ctx context.Context, model *botUserResourceModel, prior *botUserResourceModel, diags *diag.Diagnostics) {
+	editUser := &discord.EditCurrentUser{}
+	if !model.Username.IsNull() && !model.Username.IsUnknown() && model.Username.ValueString() != prior.Username.ValueString() {
+		editUser.Username = model.Username.ValueStringPointer()
+	}
+	editUser.Avatar = imageFileUpload(model.AvatarFile, &model.AvatarFileHash, prior.AvatarFileHash, path.Root("avatar_file"), diags)
+	editUser.Banner = imageFileUpload(model.BannerFile, &model.BannerFileHash, prior.BannerFileHash, path.Root("banner_file"), diags)
+	if diags.HasError() {
+		return
+	}
+
+	if editUser.Username == nil && editUser.Avatar == nil && editUser.Banner == nil {
+		model.ID = prior.ID
+		model.Username = prior.Username
+		model.AvatarHash = prior.AvatarHash
+		model.BannerHash = prior.BannerHash
+		model.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+		return
+	}
+
+	user, apiResponse, err := b.client.EditCurrentUser(ctx, editUser)
+	if err != nil {
+		diags.AddError(
+			"Error Editing Discord Bot User",
+			"Could not edit bot user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Error Editing Discord Bot User",
+			fmt.Sprintf("Could not edit bot user, unexpected status code: %d", apiResponse.StatusCode),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	model.fromUser(user)
+}
+
+// currentUser fetches the bot user as a model, used as the prior state when the resource adopts it.
+func (b *botUserResource) currentUser(ctx context.Context, diags *diag.Diagnostics) *botUserResourceModel {
+	user, apiResponse, err := b.client.GetCurrentUser(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Discord Bot User",
+			"Could not read Discord Bot User | Error: "+err.Error(),
+		)
+		return nil
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Error Reading Discord Bot User",
+			"Could not read Discord Bot User: "+apiResponse.Status,
+		)
+		return nil
+	}
+
+	model := &botUserResourceModel{}
+	model.fromUser(user)
+	return model
+}
+
+func (b *botUserResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan botUserResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The bot user already exists, so creating the resource applies the configured profile to it
+	current := b.currentUser(ctx, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	b.apply(ctx, &plan, current, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (b *botUserResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state botUserResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed bot user value from discord
+	current := b.currentUser(ctx, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ID.IsNull() && !state.ID.IsUnknown() && state.ID.ValueString() != current.ID.ValueString() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Mismatched Discord Bot User",
+			fmt.Sprintf("The resource tracks bot user ID %s but the provider token belongs to bot user ID %s. "+
+				"The bot user resource can only manage the bot user of the configured token", state.ID.ValueString(), current.ID.ValueString()),
+		)
+		return
+	}
+
+	// An image changed outside of terraform no longer matches the local file, so its hash is cleared to upload the file again
+	if imageFileDrifted(state.AvatarHash, current.AvatarHash.ValueStringPointer()) {
+		state.AvatarFileHash = types.StringNull()
+	}
+	if imageFileDrifted(state.BannerHash, current.BannerHash.ValueStringPointer()) {
+		state.BannerFileHash = types.StringNull()
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = current.ID
+	state.Username = current.Username
+	state.AvatarHash = current.AvatarHash
+	state.BannerHash = current.BannerHash
+	state.LastUpdated = current.LastUpdated
+
+	// Set refreshed state
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (b *botUserResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan botUserResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state botUserResourceModel
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	b.apply(ctx, &plan, &state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (b *botUserResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// The bot user cannot be deleted through the API, so its profile is left as it is and only removed from state
+}
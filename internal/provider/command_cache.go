@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"sync"
+)
+
+// commandCache holds the global commands of each application for the lifetime of a provider instance, so that
+// refreshing many command resources lists the commands once instead of fetching every command separately.
+type commandCache struct {
+	mu      sync.Mutex
+	entries map[string]*commandCacheEntry
+}
+
+// commandCacheEntry is guarded by its own lock so concurrent reads of one application wait on a single list call
+// without blocking reads of other applications.
+type commandCacheEntry struct {
+	mu       sync.Mutex
+	commands map[string]discord.ApplicationCommand
+}
+
+func newCommandCache() *commandCache {
+	return &commandCache{entries: map[string]*commandCacheEntry{}}
+}
+
+func (c *commandCache) entry(applicationID string) *commandCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[applicationID]
+	if !ok {
+		entry = &commandCacheEntry{}
+		c.entries[applicationID] = entry
+	}
+	return entry
+}
+
+// list returns the commands of the application keyed by command ID, calling GetCommands only when they are not
+// already cached. A nil http.Response means the commands were served from the cache. Failed calls are not cached.
+func (c *commandCache) list(ctx context.Context, client *discord.Client, applicationID string) (map[string]discord.ApplicationCommand, *http.Response, error) {
+	entry := c.entry(applicationID)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.commands != nil {
+		return entry.commands, nil, nil
+	}
+
+	commands, apiResponse, err := client.GetCommands(ctx, applicationID)
+	if err != nil || apiResponse.StatusCode != http.StatusOK {
+		return nil, apiResponse, err
+	}
+
+	entry.commands = map[string]discord.ApplicationCommand{}
+	if commands != nil {
+		for _, command := range *commands {
+			entry.commands[command.ID] = command
+		}
+	}
+
+	tflog.Debug(ctx, "Cached Discord application commands", map[string]any{
+		"application_id": applicationID,
+		"commands":       len(entry.commands),
+	})
+
+	return entry.commands, apiResponse, nil
+}
+
+// invalidate drops the cached commands of the application after they were created, updated or deleted.
+func (c *commandCache) invalidate(applicationID string) {
+	entry := c.entry(applicationID)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	entry.commands = nil
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+)
+
+// commandListServer serves one command for every application, counting the list calls made for each. Applications
+// listed in failing respond with a 500.
+type commandListServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	fetches map[string]int
+	failing map[string]bool
+}
+
+func newCommandListServer(t *testing.T) *commandListServer {
+	t.Helper()
+
+	server := &commandListServer{fetches: map[string]int{}, failing: map[string]bool{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		applicationID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v10/applications/"), "/commands")
+
+		server.mu.Lock()
+		server.fetches[applicationID]++
+		failing := server.failing[applicationID]
+		server.mu.Unlock()
+
+		// Hold the response briefly so concurrent callers overlap with the first list call
+		time.Sleep(10 * time.Millisecond)
+
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `[{"id":"%s1","application_id":"%s","name":"ban","description":"Ban a member","type":1}]`, applicationID, applicationID)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *commandListServer) fetchCount(applicationID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches[applicationID]
+}
+
+func (s *commandListServer) setFailing(applicationID string, failing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing[applicationID] = failing
+}
+
+func (s *commandListServer) client() *discord.Client {
+	return discord.NewClient(&discord.Config{Token: "token", BaseUrl: s.URL, HTTPClient: s.Client()})
+}
+
+func TestCommandCacheConcurrentList(t *testing.T) {
+	server := newCommandListServer(t)
+	client := server.client()
+	cache := newCommandCache()
+	applicationIDs := []string{"100", "200"}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20*len(applicationIDs))
+	for i := 0; i < 20; i++ {
+		for _, applicationID := range applicationIDs {
+			wg.Add(1)
+			go func(applicationID string) {
+				defer wg.Done()
+
+				commands, _, err := cache.list(context.Background(), client, applicationID)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if _, ok := commands[applicationID+"1"]; !ok || len(commands) != 1 {
+					errs <- fmt.Errorf("expected command %s1 of application %s, got %v", applicationID, applicationID, commands)
+				}
+			}(applicationID)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	for _, applicationID := range applicationIDs {
+		if got := server.fetchCount(applicationID); got != 1 {
+			t.Errorf("expected application %s to be listed once, got %d", applicationID, got)
+		}
+	}
+}
+
+func TestCommandCacheInvalidate(t *testing.T) {
+	server := newCommandListServer(t)
+	client := server.client()
+	cache := newCommandCache()
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := cache.list(context.Background(), client, "100"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got := server.fetchCount("100"); got != 1 {
+		t.Fatalf("expected a cached second list, got %d fetches", got)
+	}
+
+	cache.invalidate("100")
+	cache.invalidate("200")
+
+	_, apiResponse, err := cache.list(context.Background(), client, "100")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiResponse == nil {
+		t.Error("expected a response from refetching the invalidated commands")
+	}
+	if got := server.fetchCount("100"); got != 2 {
+		t.Errorf("expected invalidate to force a refetch, got %d fetches", got)
+	}
+	if got := server.fetchCount("200"); got != 0 {
+		t.Errorf("expected invalidating an uncached application not to list it, got %d fetches", got)
+	}
+}
+
+func TestCommandCacheDoesNotCacheFailures(t *testing.T) {
+	server := newCommandListServer(t)
+	client := server.client()
+	cache := newCommandCache()
+
+	server.setFailing("100", true)
+	commands, apiResponse, err := cache.list(context.Background(), client, "100")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiResponse == nil || apiResponse.StatusCode != http.StatusInternalServerError || commands != nil {
+		t.Fatalf("expected the failed response without commands, got %v", commands)
+	}
+
+	server.setFailing("100", false)
+	commands, apiResponse, err = cache.list(context.Background(), client, "100")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiResponse == nil || len(commands) != 1 {
+		t.Errorf("expected the commands to be refetched after a failure, got %v", commands)
+	}
+	if got := server.fetchCount("100"); got != 2 {
+		t.Errorf("expected a fetch for each call, got %d", got)
+	}
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"math/big"
+	"slices"
+	"sort"
+)
+
+// parseCommandDefinition decodes a command JSON document, such as those exported by discord.js or discord.py. Read-only
+// and client side fields those exports include, such as name_localized, are ignored.
+func parseCommandDefinition(definition string) (*discord.ApplicationCommand, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(definition)))
+	decoder.UseNumber()
+
+	var command discord.ApplicationCommand
+	if err := decoder.Decode(&command); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected content after the command object")
+	}
+
+	return &command, nil
+}
+
+// validateCommandDefinition checks a command definition against the rules applied to the typed attributes.
+func validateCommandDefinition(definition string, definitionPath path.Path, diags *diag.Diagnostics) {
+	command, err := parseCommandDefinition(definition)
+	if err != nil {
+		diags.AddAttributeError(definitionPath, "Invalid command definition", "Could not parse definition_json as a Discord command: "+err.Error())
+		return
+	}
+
+	if command.Name == "" {
+		diags.AddAttributeError(definitionPath, "Invalid command definition", "The command definition must contain a name")
+	}
+	if (command.Type == 0 || command.Type == 1) && command.Description == "" {
+		diags.AddAttributeError(definitionPath, "Invalid command definition", "CHAT_INPUT command definitions must contain a description")
+	}
+
+	options, optionDiags := commandOptionsToList(command.Options, 1)
+	if optionDiags.HasError() {
+		appendDefinitionDiagnostics(definitionPath, optionDiags, diags)
+		return
+	}
+
+	var nestedDiags diag.Diagnostics
+	validateCommandOptions(options, path.Root("options"), &nestedDiags)
+	appendDefinitionDiagnostics(definitionPath, nestedDiags, diags)
+}
+
+// appendDefinitionDiagnostics reports diagnostics raised against the decoded definition on the definition_json attribute
+// itself, prefixing each with the JSON path it relates to.
+func appendDefinitionDiagnostics(definitionPath path.Path, definitionDiags diag.Diagnostics, diags *diag.Diagnostics) {
+	for _, d := range definitionDiags.Errors() {
+		detail := d.Detail()
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			detail = withPath.Path().String() + ": " + detail
+		}
+		diags.AddAttributeError(definitionPath, "Invalid command definition", d.Summary()+" - "+detail)
+	}
+}
+
+var (
+	// defaultCommandIntegrationTypes is the guild install only integration type discord gives commands without one
+	defaultCommandIntegrationTypes = []int{0}
+	// defaultCommandContexts are the guild, bot DM and private channel contexts discord gives commands without any
+	defaultCommandContexts = []int{0, 1, 2}
+)
+
+// normalizeCommand returns a copy of the command with server assigned fields removed and every value Discord treats as a
+// default collapsed, so that two semantically equal commands have identical representations.
+func normalizeCommand(command discord.ApplicationCommand) discord.ApplicationCommand {
+	command.ID = ""
+	command.ApplicationID = ""
+	command.GuildID = nil
+	command.Version = ""
+	command.DefaultPermission = nil
+
+	if command.Type == 0 {
+		command.Type = 1
+	}
+	command.NameLocalizations = normalizeLocalizations(command.NameLocalizations)
+	command.DescriptionLocalizations = normalizeLocalizations(command.DescriptionLocalizations)
+	command.Options = normalizeOptions(command.Options)
+	if command.DmPermission != nil && *command.DmPermission {
+		command.DmPermission = nil
+	}
+	if command.Nsfw != nil && !*command.Nsfw {
+		command.Nsfw = nil
+	}
+	// Commands are installable to guilds only and usable in every context unless told otherwise
+	command.IntegrationTypes = normalizeInts(command.IntegrationTypes)
+	if command.IntegrationTypes != nil && slices.Equal(*command.IntegrationTypes, defaultCommandIntegrationTypes) {
+		command.IntegrationTypes = nil
+	}
+	command.Contexts = normalizeInts(command.Contexts)
+	if command.Contexts != nil && slices.Equal(*command.Contexts, defaultCommandContexts) {
+		command.Contexts = nil
+	}
+
+	return command
+}
+
+func normalizeOptions(options *[]discord.ApplicationCommandOption) *[]discord.ApplicationCommandOption {
+	if options == nil || len(*options) == 0 {
+		return nil
+	}
+
+	normalized := make([]discord.ApplicationCommandOption, len(*options))
+	for i, option := range *options {
+		option.NameLocalizations = normalizeLocalizations(option.NameLocalizations)
+		option.DescriptionLocalizations = normalizeLocalizations(option.DescriptionLocalizations)
+		if option.Required != nil && !*option.Required {
+			option.Required = nil
+		}
+		if option.AutoComplete != nil && !*option.AutoComplete {
+			option.AutoComplete = nil
+		}
+		if option.ChannelTypes != nil && len(*option.ChannelTypes) == 0 {
+			option.ChannelTypes = nil
+		}
+		option.MinValue = canonicalNumber(option.MinValue)
+		option.MaxValue = canonicalNumber(option.MaxValue)
+
+		if option.Choices != nil && len(*option.Choices) == 0 {
+			option.Choices = nil
+		}
+		if option.Choices != nil {
+			choices := make([]discord.ApplicationCommandOptionChoice, len(*option.Choices))
+			for j, choice := range *option.Choices {
+				choice.NameLocalizations = normalizeLocalizations(choice.NameLocalizations)
+				if number, ok := choice.Value.(json.Number); ok {
+					choice.Value = *canonicalNumber(&number)
+				}
+				choices[j] = choice
+			}
+			option.Choices = &choices
+		}
+
+		option.Options = normalizeOptions(option.Options)
+		normalized[i] = option
+	}
+
+	return &normalized
+}
+
+// normalizeInts sorts a list of enum values whose order Discord does not preserve.
+func normalizeInts(values *[]int) *[]int {
+	if values == nil || len(*values) == 0 {
+		return nil
+	}
+
+	sorted := append([]int{}, *values...)
+	sort.Ints(sorted)
+	return &sorted
+}
+
+func normalizeLocalizations(localizations *map[string]string) *map[string]string {
+	if localizations == nil || len(*localizations) == 0 {
+		return nil
+	}
+	return localizations
+}
+
+// canonicalNumber rewrites a JSON number in its shortest exact decimal form, so 1.50 and 1.5 or 1e2 and 100 compare
+// equal.
+func canonicalNumber(number *json.Number) *json.Number {
+	if number == nil {
+		return nil
+	}
+
+	value, _, err := big.ParseFloat(number.String(), 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return number
+	}
+
+	var canonical json.Number
+	if value.IsInt() {
+		canonical = json.Number(value.Text('f', 0))
+	} else {
+		canonical = json.Number(value.Text('f', -1))
+	}
+	return &canonical
+}
+
+// commandDefinitionJSON renders the normalised form of a command as JSON.
+func commandDefinitionJSON(command discord.ApplicationCommand) (string, error) {
+	normalized, err := json.Marshal(normalizeCommand(command))
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+// commandDifferences lists the top level fields which differ between the normalised forms of two commands.
+func commandDifferences(a, b discord.ApplicationCommand) ([]string, error) {
+	fieldsA, err := commandFields(a)
+	if err != nil {
+		return nil, err
+	}
+	fieldsB, err := commandFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []string
+	for field, value := range fieldsA {
+		if other, ok := fieldsB[field]; !ok || !bytes.Equal(value, other) {
+			differences = append(differences, field)
+		}
+	}
+	for field := range fieldsB {
+		if _, ok := fieldsA[field]; !ok {
+			differences = append(differences, field)
+		}
+	}
+	sort.Strings(differences)
+
+	return differences, nil
+}
+
+func commandFields(command discord.ApplicationCommand) (map[string]json.RawMessage, error) {
+	normalized, err := json.Marshal(normalizeCommand(command))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(normalized, &fields)
+	return fields, err
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalNumber(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "1", want: "1"},
+		{in: "1.0", want: "1"},
+		{in: "1.50", want: "1.5"},
+		{in: "1e2", want: "100"},
+		{in: "-0.25", want: "-0.25"},
+		{in: "9007199254740993", want: "9007199254740993"},
+		{in: "9007199254740992.5", want: "9007199254740992.5"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			in := json.Number(test.in)
+			got := canonicalNumber(&in)
+			if got == nil || got.String() != test.want {
+				t.Errorf("expected %s, got %v", test.want, got)
+			}
+		})
+	}
+
+	if canonicalNumber(nil) != nil {
+		t.Error("expected nil for a nil number")
+	}
+}
+
+func TestCommandDefinitionSemanticEquality(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		new   string
+		want  bool
+	}{
+		{
+			name:  "identical",
+			prior: `{"name":"ban","description":"Ban a member"}`,
+			new:   `{"name":"ban","description":"Ban a member"}`,
+			want:  true,
+		},
+		{
+			name:  "key order and whitespace",
+			prior: `{"name":"ban","description":"Ban a member","type":1}`,
+			new: `{
+				"type": 1,
+				"description": "Ban a member",
+				"name": "ban"
+			}`,
+			want: true,
+		},
+		{
+			name:  "defaults filled in by discord",
+			prior: `{"name":"ban","description":"Ban a member","options":[{"type":6,"name":"member","description":"The member"}]}`,
+			new: `{"name":"ban","description":"Ban a member","type":1,"dm_permission":true,"nsfw":false,"name_localizations":{},
+				"description_localizations":{},"integration_types":[0],
+				"options":[{"type":6,"name":"member","description":"The member","required":false,"autocomplete":false,"name_localizations":{}}]}`,
+			want: true,
+		},
+		{
+			name:  "exported read-only fields",
+			prior: `{"name":"ban","description":"Ban a member"}`,
+			new: `{"id":"1234567890987654321","application_id":"9876543210123456789","version":"1234567890987654322",
+				"guild_id":"1111111111111111111","default_permission":true,"name":"ban","name_localized":"ban",
+				"description":"Ban a member","description_localized":"Ban a member"}`,
+			want: true,
+		},
+		{
+			name:  "number formats",
+			prior: `{"name":"roll","description":"Roll","options":[{"type":10,"name":"sides","description":"Sides","min_value":1,"max_value":1e2,"choices":[{"name":"two","value":2}]}]}`,
+			new:   `{"name":"roll","description":"Roll","options":[{"type":10,"name":"sides","description":"Sides","min_value":1.0,"max_value":100,"choices":[{"name":"two","value":2.00}]}]}`,
+			want:  true,
+		},
+		{
+			name:  "unordered enum lists",
+			prior: `{"name":"ban","description":"Ban a member","integration_types":[1,0],"contexts":[2,0,1]}`,
+			new:   `{"name":"ban","description":"Ban a member","integration_types":[0,1],"contexts":[0,1,2]}`,
+			want:  true,
+		},
+		{
+			name:  "default install contexts",
+			prior: `{"name":"ban","description":"Ban a member"}`,
+			new:   `{"name":"ban","description":"Ban a member","integration_types":[0],"contexts":[2,1,0]}`,
+			want:  true,
+		},
+		{
+			name:  "different description",
+			prior: `{"name":"ban","description":"Ban a member"}`,
+			new:   `{"name":"ban","description":"Ban someone"}`,
+		},
+		{
+			name:  "different number",
+			prior: `{"name":"roll","description":"Roll","options":[{"type":10,"name":"sides","description":"Sides","min_value":1}]}`,
+			new:   `{"name":"roll","description":"Roll","options":[{"type":10,"name":"sides","description":"Sides","min_value":1.5}]}`,
+		},
+		{
+			name:  "non default dm_permission",
+			prior: `{"name":"ban","description":"Ban a member"}`,
+			new:   `{"name":"ban","description":"Ban a member","dm_permission":false}`,
+		},
+		{
+			name:  "option order",
+			prior: `{"name":"ban","description":"Ban","options":[{"type":6,"name":"a","description":"A"},{"type":3,"name":"b","description":"B"}]}`,
+			new:   `{"name":"ban","description":"Ban","options":[{"type":3,"name":"b","description":"B"},{"type":6,"name":"a","description":"A"}]}`,
+		},
+		{
+			name:  "invalid json",
+			prior: `{"name":"ban","description":"Ban a member"}`,
+			new:   `{"name":"ban",`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, diags := NewCommandDefinitionValue(test.prior).StringSemanticEquals(context.Background(), NewCommandDefinitionValue(test.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got != test.want {
+				t.Errorf("expected %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandDefinitionSemanticEqualityWrongType(t *testing.T) {
+	_, diags := NewCommandDefinitionValue(`{}`).StringSemanticEquals(context.Background(), types.StringValue(`{}`))
+	if !diags.HasError() {
+		t.Error("expected an error comparing against a plain string value")
+	}
+}
+
+func TestCommandDifferences(t *testing.T) {
+	a, err := parseCommandDefinition(`{"name":"ban","description":"Ban a member","nsfw":false}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	b, err := parseCommandDefinition(`{"name":"ban","description":"Ban someone","nsfw":true,"default_member_permissions":"4"}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	differences, err := commandDifferences(*a, *b)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{"default_member_permissions", "description", "nsfw"}
+	if strings.Join(differences, ",") != strings.Join(want, ",") {
+		t.Errorf("expected %v, got %v", want, differences)
+	}
+}
+
+func TestParseCommandDefinition(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		wantError  bool
+	}{
+		{name: "minimal", definition: `{"name":"ban","description":"Ban a member"}`},
+		{name: "unknown fields", definition: `{"name":"ban","description":"Ban a member","name_localized":"ban","options":[{"type":3,"name":"a","description":"A","description_localized":"A"}]}`},
+		{name: "trailing content", definition: `{"name":"ban","description":"Ban a member"} {}`, wantError: true},
+		{name: "not an object", definition: `["ban"]`, wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseCommandDefinition(test.definition)
+			if (err != nil) != test.wantError {
+				t.Errorf("expected error %t, got %v", test.wantError, err)
+			}
+		})
+	}
+}
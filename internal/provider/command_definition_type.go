@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = CommandDefinitionType{}
+	_ basetypes.StringValuable                   = CommandDefinitionValue{}
+	_ basetypes.StringValuableWithSemanticEquals = CommandDefinitionValue{}
+)
+
+// CommandDefinitionType is a string attribute type holding a Discord command JSON document. Two definitions are
+// semantically equal when they describe the same command, so key order, whitespace and defaults never produce a diff.
+type CommandDefinitionType struct {
+	basetypes.StringType
+}
+
+func (t CommandDefinitionType) Equal(o attr.Type) bool {
+	other, ok := o.(CommandDefinitionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t CommandDefinitionType) String() string {
+	return "CommandDefinitionType"
+}
+
+func (t CommandDefinitionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CommandDefinitionValue{StringValue: in}, nil
+}
+
+func (t CommandDefinitionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return CommandDefinitionValue{StringValue: stringValue}, nil
+}
+
+func (t CommandDefinitionType) ValueType(_ context.Context) attr.Value {
+	return CommandDefinitionValue{}
+}
+
+// CommandDefinitionValue is the value of a CommandDefinitionType attribute.
+type CommandDefinitionValue struct {
+	basetypes.StringValue
+}
+
+func NewCommandDefinitionValue(value string) CommandDefinitionValue {
+	return CommandDefinitionValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v CommandDefinitionValue) Equal(o attr.Value) bool {
+	other, ok := o.(CommandDefinitionValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v CommandDefinitionValue) Type(_ context.Context) attr.Type {
+	return CommandDefinitionType{}
+}
+
+// StringSemanticEquals reports whether both definitions describe the same command. Definitions which cannot be parsed
+// are never equal, leaving validation to report them.
+func (v CommandDefinitionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CommandDefinitionValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := parseCommandDefinition(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	current, err := parseCommandDefinition(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	differences, err := commandDifferences(*prior, *current)
+	return err == nil && len(differences) == 0, diags
+}
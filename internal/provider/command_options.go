@@ -0,0 +1,495 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"math/big"
+)
+
+// maxCommandOptionDepth is the deepest Discord allows options to be nested: sub command group > sub command > option
+const maxCommandOptionDepth = 3
+
+// numberPrecision matches the precision Terraform uses for number values so that parsed values compare equal.
+const numberPrecision = 512
+
+var (
+	// Discord only accepts min_value and max_value between -2^53 and 2^53
+	minOptionValue = new(big.Float).SetInt64(-(1 << 53))
+	maxOptionValue = new(big.Float).SetInt64(1 << 53)
+)
+
+var commandOptionChoiceAttrTypes = map[string]attr.Type{
+	"name":               types.StringType,
+	"name_localizations": types.MapType{ElemType: types.StringType},
+	"value":              types.StringType,
+}
+
+func commandOptionAttrTypes(depth int) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"type":                      types.Int64Type,
+		"name":                      types.StringType,
+		"name_localizations":        types.MapType{ElemType: types.StringType},
+		"description":               types.StringType,
+		"description_localizations": types.MapType{ElemType: types.StringType},
+		"required":                  types.BoolType,
+		"autocomplete":              types.BoolType,
+		"channel_types":             types.ListType{ElemType: types.Int64Type},
+		"min_value":                 types.NumberType,
+		"max_value":                 types.NumberType,
+		"min_length":                types.Int64Type,
+		"max_length":                types.Int64Type,
+		"choice":                    types.ListType{ElemType: types.ObjectType{AttrTypes: commandOptionChoiceAttrTypes}},
+	}
+	if depth < maxCommandOptionDepth {
+		attrTypes["option"] = types.ListType{ElemType: types.ObjectType{AttrTypes: commandOptionAttrTypes(depth + 1)}}
+	}
+	return attrTypes
+}
+
+// commandOptionBlock builds the schema of an option block. Nested option blocks are added until maxCommandOptionDepth
+// is reached.
+func commandOptionBlock(depth int) schema.ListNestedBlock {
+	blocks := map[string]schema.Block{
+		"choice": schema.ListNestedBlock{
+			Description: "A predefined choice for the user to pick from. Only valid for STRING, INTEGER and NUMBER options",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the choice - displayed in discord",
+						Required:    true,
+					},
+					"name_localizations": schema.MapAttribute{
+						Description: "Localized names of the choice, keyed by Discord locale",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The value of the choice. Must be numeric for INTEGER and NUMBER options",
+						Required:    true,
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(25),
+			},
+		},
+	}
+	if depth < maxCommandOptionDepth {
+		blocks["option"] = commandOptionBlock(depth + 1)
+	}
+
+	return schema.ListNestedBlock{
+		Description: "A parameter of the command, or a sub command / sub command group. Required options must be listed before optional ones",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.Int64Attribute{
+					Description: "The type of option - see discord application API documentation for more info",
+					Required:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the option",
+					Required:    true,
+				},
+				"name_localizations": schema.MapAttribute{
+					Description: "Localized names of the option, keyed by Discord locale",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "The description of the option - displayed in discord",
+					Required:    true,
+				},
+				"description_localizations": schema.MapAttribute{
+					Description: "Localized descriptions of the option, keyed by Discord locale",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"required": schema.BoolAttribute{
+					Description: "Whether the option must be provided by the user. Defaults to false",
+					Optional:    true,
+				},
+				"autocomplete": schema.BoolAttribute{
+					Description: "Whether autocomplete interactions are enabled for the option. Cannot be combined with choices. Defaults to false",
+					Optional:    true,
+				},
+				"channel_types": schema.ListAttribute{
+					Description: "The channel types shown to the user. Only valid for CHANNEL options",
+					ElementType: types.Int64Type,
+					Optional:    true,
+				},
+				"min_value": schema.NumberAttribute{
+					Description: "The minimum value permitted. Only valid for INTEGER and NUMBER options, and must be a whole number for INTEGER options",
+					Optional:    true,
+				},
+				"max_value": schema.NumberAttribute{
+					Description: "The maximum value permitted. Only valid for INTEGER and NUMBER options, and must be a whole number for INTEGER options",
+					Optional:    true,
+				},
+				"min_length": schema.Int64Attribute{
+					Description: "The minimum length permitted (0 - 6000). Only valid for STRING options",
+					Optional:    true,
+				},
+				"max_length": schema.Int64Attribute{
+					Description: "The maximum length permitted (1 - 6000). Only valid for STRING options",
+					Optional:    true,
+				},
+			},
+			Blocks: blocks,
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(25),
+		},
+	}
+}
+
+// commandOptionsFromList converts option blocks into their API representation. Unknown values are treated as unset.
+func commandOptionsFromList(options types.List) []discord.ApplicationCommandOption {
+	result := []discord.ApplicationCommandOption{}
+	if options.IsNull() || options.IsUnknown() {
+		return result
+	}
+
+	for _, element := range options.Elements() {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		attributes := element.(types.Object).Attributes()
+
+		option := discord.ApplicationCommandOption{
+			Type:                     discord.ApplicationCommandOptionType(attributes["type"].(types.Int64).ValueInt64()),
+			Name:                     attributes["name"].(types.String).ValueString(),
+			NameLocalizations:        localizationsFromMap(attributes["name_localizations"].(types.Map)),
+			Description:              attributes["description"].(types.String).ValueString(),
+			DescriptionLocalizations: localizationsFromMap(attributes["description_localizations"].(types.Map)),
+			Required:                 knownBoolPointer(attributes["required"].(types.Bool)),
+			AutoComplete:             knownBoolPointer(attributes["autocomplete"].(types.Bool)),
+			MinLength:                knownIntPointer(attributes["min_length"].(types.Int64)),
+			MaxLength:                knownIntPointer(attributes["max_length"].(types.Int64)),
+		}
+
+		integer := option.Type == discord.ApplicationCommandOptionTypeInteger
+		option.MinValue = numberToJSON(attributes["min_value"].(types.Number), integer)
+		option.MaxValue = numberToJSON(attributes["max_value"].(types.Number), integer)
+
+		if channelTypes := attributes["channel_types"].(types.List); !channelTypes.IsNull() && !channelTypes.IsUnknown() {
+			option.ChannelTypes = &[]int{}
+			for _, channelType := range channelTypes.Elements() {
+				*option.ChannelTypes = append(*option.ChannelTypes, int(channelType.(types.Int64).ValueInt64()))
+			}
+		}
+
+		if choices := attributes["choice"].(types.List); len(choices.Elements()) > 0 {
+			option.Choices = &[]discord.ApplicationCommandOptionChoice{}
+			for _, choice := range choices.Elements() {
+				choiceAttributes := choice.(types.Object).Attributes()
+				*option.Choices = append(*option.Choices, discord.ApplicationCommandOptionChoice{
+					Name:              choiceAttributes["name"].(types.String).ValueString(),
+					NameLocalizations: localizationsFromMap(choiceAttributes["name_localizations"].(types.Map)),
+					Value:             choiceValueToJSON(option.Type, choiceAttributes["value"].(types.String).ValueString()),
+				})
+			}
+		}
+
+		if nested, ok := attributes["option"]; ok && len(nested.(types.List).Elements()) > 0 {
+			nestedOptions := commandOptionsFromList(nested.(types.List))
+			option.Options = &nestedOptions
+		}
+
+		result = append(result, option)
+	}
+
+	return result
+}
+
+// commandOptionsToList converts API options into option blocks of the given depth.
+func commandOptionsToList(options *[]discord.ApplicationCommandOption, depth int) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elementType := types.ObjectType{AttrTypes: commandOptionAttrTypes(depth)}
+
+	elements := []attr.Value{}
+	if options != nil {
+		for _, option := range *options {
+			minValue, err := numberFromJSON(option.MinValue)
+			if err != nil {
+				diags.AddError("Invalid min_value returned by Discord", err.Error())
+			}
+			maxValue, err := numberFromJSON(option.MaxValue)
+			if err != nil {
+				diags.AddError("Invalid max_value returned by Discord", err.Error())
+			}
+
+			channelTypes := types.ListNull(types.Int64Type)
+			if option.ChannelTypes != nil {
+				channelTypeValues := []attr.Value{}
+				for _, channelType := range *option.ChannelTypes {
+					channelTypeValues = append(channelTypeValues, types.Int64Value(int64(channelType)))
+				}
+				channelTypes = types.ListValueMust(types.Int64Type, channelTypeValues)
+			}
+
+			choices := []attr.Value{}
+			if option.Choices != nil {
+				for _, choice := range *option.Choices {
+					choices = append(choices, types.ObjectValueMust(commandOptionChoiceAttrTypes, map[string]attr.Value{
+						"name":               types.StringValue(choice.Name),
+						"name_localizations": localizationsToMap(choice.NameLocalizations),
+						"value":              types.StringValue(fmt.Sprint(choice.Value)),
+					}))
+				}
+			}
+
+			attributes := map[string]attr.Value{
+				"type":                      types.Int64Value(int64(option.Type)),
+				"name":                      types.StringValue(option.Name),
+				"name_localizations":        localizationsToMap(option.NameLocalizations),
+				"description":               types.StringValue(option.Description),
+				"description_localizations": localizationsToMap(option.DescriptionLocalizations),
+				"required":                  types.BoolPointerValue(option.Required),
+				"autocomplete":              types.BoolPointerValue(option.AutoComplete),
+				"channel_types":             channelTypes,
+				"min_value":                 minValue,
+				"max_value":                 maxValue,
+				"min_length":                intPointerValue(option.MinLength),
+				"max_length":                intPointerValue(option.MaxLength),
+				"choice":                    types.ListValueMust(types.ObjectType{AttrTypes: commandOptionChoiceAttrTypes}, choices),
+			}
+
+			if depth < maxCommandOptionDepth {
+				nested, nestedDiags := commandOptionsToList(option.Options, depth+1)
+				diags.Append(nestedDiags...)
+				attributes["option"] = nested
+			} else if option.Options != nil && len(*option.Options) > 0 {
+				diags.AddError("Unsupported command option nesting", fmt.Sprintf("Option %s has options nested deeper than Discord allows", option.Name))
+			}
+
+			element, elementDiags := types.ObjectValue(elementType.AttrTypes, attributes)
+			diags.Append(elementDiags...)
+			elements = append(elements, element)
+		}
+	}
+
+	if diags.HasError() {
+		return types.ListNull(elementType), diags
+	}
+
+	list, listDiags := types.ListValue(elementType, elements)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+// validateCommandOptions checks the rules Discord applies to options which the schema alone cannot express.
+func validateCommandOptions(options types.List, optionsPath path.Path, diags *diag.Diagnostics) {
+	if options.IsNull() || options.IsUnknown() {
+		return
+	}
+
+	for i, element := range options.Elements() {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		optionPath := optionsPath.AtListIndex(i)
+		attributes := element.(types.Object).Attributes()
+
+		optionType := attributes["type"].(types.Int64)
+		if optionType.IsUnknown() {
+			continue
+		}
+		t := discord.ApplicationCommandOptionType(optionType.ValueInt64())
+		numeric := t == discord.ApplicationCommandOptionTypeInteger || t == discord.ApplicationCommandOptionTypeNumber
+
+		var bounds [2]*big.Float
+		for j, name := range []string{"min_value", "max_value"} {
+			value := attributes[name].(types.Number)
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			switch {
+			case !numeric:
+				diags.AddAttributeError(optionPath.AtName(name), "Invalid command option", name+" can only be set on INTEGER and NUMBER options")
+			case t == discord.ApplicationCommandOptionTypeInteger && !value.ValueBigFloat().IsInt():
+				diags.AddAttributeError(optionPath.AtName(name), "Invalid command option", name+" must be a whole number for INTEGER options")
+			case value.ValueBigFloat().Cmp(minOptionValue) < 0 || value.ValueBigFloat().Cmp(maxOptionValue) > 0:
+				diags.AddAttributeError(optionPath.AtName(name), "Invalid command option", name+" must be between -2^53 and 2^53")
+			default:
+				bounds[j] = value.ValueBigFloat()
+			}
+		}
+		if bounds[0] != nil && bounds[1] != nil && bounds[0].Cmp(bounds[1]) > 0 {
+			diags.AddAttributeError(optionPath.AtName("min_value"), "Invalid command option", "min_value must be less than or equal to max_value")
+		}
+
+		var lengths [2]*int64
+		for j, name := range []string{"min_length", "max_length"} {
+			value := attributes[name].(types.Int64)
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			lowest := int64(j) // min_length may be 0, max_length must be at least 1
+			switch {
+			case t != discord.ApplicationCommandOptionTypeString:
+				diags.AddAttributeError(optionPath.AtName(name), "Invalid command option", name+" can only be set on STRING options")
+			case value.ValueInt64() < lowest || value.ValueInt64() > 6000:
+				diags.AddAttributeError(optionPath.AtName(name), "Invalid command option", fmt.Sprintf("%s must be between %d and 6000", name, lowest))
+			default:
+				lengths[j] = value.ValueInt64Pointer()
+			}
+		}
+		if lengths[0] != nil && lengths[1] != nil && *lengths[0] > *lengths[1] {
+			diags.AddAttributeError(optionPath.AtName("min_length"), "Invalid command option", "min_length must be less than or equal to max_length")
+		}
+
+		for j, choice := range attributes["choice"].(types.List).Elements() {
+			value := choice.(types.Object).Attributes()["value"].(types.String)
+			if !numeric || value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			number, _, err := big.ParseFloat(value.ValueString(), 10, numberPrecision, big.ToNearestEven)
+			if err != nil || (t == discord.ApplicationCommandOptionTypeInteger && !number.IsInt()) {
+				diags.AddAttributeError(optionPath.AtName("choice").AtListIndex(j).AtName("value"), "Invalid command option choice",
+					"The value of a choice must be a number for NUMBER options and a whole number for INTEGER options")
+			}
+		}
+
+		if nested, ok := attributes["option"]; ok {
+			if len(nested.(types.List).Elements()) > 0 && t != discord.ApplicationCommandOptionTypeSubCommand && t != discord.ApplicationCommandOptionTypeSubCommandGroup {
+				diags.AddAttributeError(optionPath.AtName("option"), "Invalid command option", "Only SUB_COMMAND and SUB_COMMAND_GROUP options can contain options")
+			}
+			validateCommandOptions(nested.(types.List), optionPath.AtName("option"), diags)
+		}
+	}
+}
+
+// numberToJSON serialises a number as an exact JSON number - as an integer for INTEGER options and whole numbers, and
+// in its shortest exact decimal form, without an exponent, otherwise.
+func numberToJSON(value types.Number, integer bool) *json.Number {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var number json.Number
+	if integer || value.ValueBigFloat().IsInt() {
+		number = json.Number(value.ValueBigFloat().Text('f', 0))
+	} else {
+		number = json.Number(value.ValueBigFloat().Text('f', -1))
+	}
+	return &number
+}
+
+func numberFromJSON(value *json.Number) (types.Number, error) {
+	if value == nil {
+		return types.NumberNull(), nil
+	}
+
+	number, _, err := big.ParseFloat(value.String(), 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return types.NumberNull(), fmt.Errorf("could not parse number %q: %w", value.String(), err)
+	}
+	return types.NumberValue(number), nil
+}
+
+// choiceValueToJSON converts a configured choice value into a JSON number for numeric options and leaves it as a
+// string otherwise.
+func choiceValueToJSON(optionType discord.ApplicationCommandOptionType, value string) any {
+	switch optionType {
+	case discord.ApplicationCommandOptionTypeInteger, discord.ApplicationCommandOptionTypeNumber:
+		number, _, err := big.ParseFloat(value, 10, numberPrecision, big.ToNearestEven)
+		if err != nil {
+			return value
+		}
+		return numberToJSON(types.NumberValue(number), optionType == discord.ApplicationCommandOptionTypeInteger)
+	default:
+		return value
+	}
+}
+
+func knownBoolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
+func knownStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
+
+func intsFromSet(value types.Set) *[]int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	ints := []int{}
+	for _, element := range value.Elements() {
+		ints = append(ints, int(element.(types.Int64).ValueInt64()))
+	}
+	return &ints
+}
+
+func intsToSet(ints *[]int) types.Set {
+	if ints == nil {
+		return types.SetNull(types.Int64Type)
+	}
+
+	elements := []attr.Value{}
+	for _, i := range *ints {
+		elements = append(elements, types.Int64Value(int64(i)))
+	}
+	return types.SetValueMust(types.Int64Type, elements)
+}
+
+func knownIntPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	i := int(value.ValueInt64())
+	return &i
+}
+
+func localizationsFromMap(value types.Map) *map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	localizations := map[string]string{}
+	for locale, localization := range value.Elements() {
+		localizations[locale] = localization.(types.String).ValueString()
+	}
+	return &localizations
+}
+
+func localizationsToMap(localizations *map[string]string) types.Map {
+	if localizations == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := map[string]attr.Value{}
+	for locale, localization := range *localizations {
+		elements[locale] = types.StringValue(localization)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func intPointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNumberJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		integer bool
+		want    string
+	}{
+		{name: "integer", in: "42", integer: true, want: "42"},
+		{name: "largest bound", in: "9007199254740992", integer: true, want: "9007199254740992"},
+		{name: "smallest bound", in: "-9007199254740992", integer: true, want: "-9007199254740992"},
+		{name: "beyond float64 precision", in: "9007199254740993", integer: true, want: "9007199254740993"},
+		{name: "exponent integer", in: "1e2", integer: true, want: "100"},
+		{name: "whole number option", in: "1.0", want: "1"},
+		{name: "fraction", in: "0.1", want: "0.1"},
+		{name: "trailing zeros", in: "1.50", want: "1.5"},
+		{name: "long fraction", in: "123456789.123456789", want: "123456789.123456789"},
+		{name: "negative fraction", in: "-2.25", want: "-2.25"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := json.Number(test.in)
+			number, err := numberFromJSON(&in)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := numberToJSON(number, test.integer)
+			if got == nil || got.String() != test.want {
+				t.Errorf("expected %s, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNumberJSONNull(t *testing.T) {
+	number, err := numberFromJSON(nil)
+	if err != nil || !number.IsNull() {
+		t.Fatalf("expected a null number, got %s (%v)", number, err)
+	}
+	if got := numberToJSON(types.NumberNull(), false); got != nil {
+		t.Errorf("expected nil for a null number, got %s", got)
+	}
+	if got := numberToJSON(types.NumberUnknown(), false); got != nil {
+		t.Errorf("expected nil for an unknown number, got %s", got)
+	}
+}
+
+func TestChoiceValueToJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		optionType discord.ApplicationCommandOptionType
+		value      string
+		want       any
+	}{
+		{name: "string", optionType: discord.ApplicationCommandOptionTypeString, value: "1.50", want: "1.50"},
+		{name: "integer", optionType: discord.ApplicationCommandOptionTypeInteger, value: "9007199254740993", want: json.Number("9007199254740993")},
+		{name: "number", optionType: discord.ApplicationCommandOptionTypeNumber, value: "0.30", want: json.Number("0.3")},
+		{name: "whole number", optionType: discord.ApplicationCommandOptionTypeNumber, value: "2.0", want: json.Number("2")},
+		{name: "invalid number", optionType: discord.ApplicationCommandOptionTypeNumber, value: "abc", want: "abc"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := choiceValueToJSON(test.optionType, test.value)
+			if number, ok := got.(*json.Number); ok {
+				got = *number
+			}
+			if got != test.want {
+				t.Errorf("expected %#v, got %#v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateCommandOptionsValueBounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		optionType discord.ApplicationCommandOptionType
+		minValue   string
+		maxValue   string
+		wantError  bool
+	}{
+		{name: "within bounds", optionType: discord.ApplicationCommandOptionTypeNumber, minValue: "-1.5", maxValue: "1.5"},
+		{name: "at bounds", optionType: discord.ApplicationCommandOptionTypeInteger, minValue: "-9007199254740992", maxValue: "9007199254740992"},
+		{name: "above upper bound", optionType: discord.ApplicationCommandOptionTypeInteger, maxValue: "9007199254740993", wantError: true},
+		{name: "below lower bound", optionType: discord.ApplicationCommandOptionTypeInteger, minValue: "-9007199254740993", wantError: true},
+		{name: "fraction above upper bound", optionType: discord.ApplicationCommandOptionTypeNumber, maxValue: "9007199254740992.5", wantError: true},
+		{name: "fraction on integer", optionType: discord.ApplicationCommandOptionTypeInteger, minValue: "1.5", wantError: true},
+		{name: "min above max", optionType: discord.ApplicationCommandOptionTypeNumber, minValue: "2", maxValue: "1", wantError: true},
+		{name: "non numeric option", optionType: discord.ApplicationCommandOptionTypeString, minValue: "1", wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			option := discord.ApplicationCommandOption{Type: test.optionType, Name: "value", Description: "A value"}
+			if test.minValue != "" {
+				minValue := json.Number(test.minValue)
+				option.MinValue = &minValue
+			}
+			if test.maxValue != "" {
+				maxValue := json.Number(test.maxValue)
+				option.MaxValue = &maxValue
+			}
+
+			options, diags := commandOptionsToList(&[]discord.ApplicationCommandOption{option}, 1)
+			if diags.HasError() {
+				t.Fatalf("unexpected error building options: %v", diags)
+			}
+
+			var validateDiags diag.Diagnostics
+			validateCommandOptions(options, path.Root("option"), &validateDiags)
+			if validateDiags.HasError() != test.wantError {
+				t.Errorf("expected error %t, got %v", test.wantError, validateDiags)
+			}
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	_ resource.Resource                   = &commandPermissionsResource{}
+	_ resource.ResourceWithConfigure      = &commandPermissionsResource{}
+	_ resource.ResourceWithImportState    = &commandPermissionsResource{}
+	_ resource.ResourceWithValidateConfig = &commandPermissionsResource{}
+)
+
+// permissionTypes maps the permission type names used in configuration to Discord's numeric types.
+var permissionTypes = map[string]discord.ApplicationCommandPermissionType{
+	"role":    discord.ApplicationCommandPermissionTypeRole,
+	"user":    discord.ApplicationCommandPermissionTypeUser,
+	"channel": discord.ApplicationCommandPermissionTypeChannel,
+}
+
+func permissionTypeName(permissionType discord.ApplicationCommandPermissionType) string {
+	for name, t := range permissionTypes {
+		if t == permissionType {
+			return name
+		}
+	}
+	return fmt.Sprintf("unknown (%d)", permissionType)
+}
+
+func NewCommandPermissionsResource() resource.Resource {
+	return &commandPermissionsResource{}
+}
+
+type commandPermissionsResourceModel struct {
+	ApplicationID SnowflakeValue           `tfsdk:"application_id"`
+	GuildID       SnowflakeValue           `tfsdk:"guild_id"`
+	CommandID     SnowflakeValue           `tfsdk:"command_id"`
+	Permissions   []commandPermissionModel `tfsdk:"permission"`
+	LastUpdated   types.String             `tfsdk:"last_updated"`
+}
+
+type commandPermissionModel struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	Permission types.Bool   `tfsdk:"permission"`
+}
+
+// toRequest builds the API request body, resolving any sentinel constants against the guild ID.
+func (c *commandPermissionsResourceModel) toRequest() (*discord.EditApplicationCommandPermissions, error) {
+	request := &discord.EditApplicationCommandPermissions{
+		Permissions: []discord.ApplicationCommandPermission{},
+	}
+
+	for _, permission := range c.Permissions {
+		id, err := discord.ResolvePermissionID(c.GuildID.ValueString(), permission.ID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		request.Permissions = append(request.Permissions, discord.ApplicationCommandPermission{
+			ID:         id,
+			Type:       permissionTypes[permission.Type.ValueString()],
+			Permission: permission.Permission.ValueBool(),
+		})
+	}
+
+	return request, nil
+}
+
+// fromPermissions maps the API response onto the model. IDs are kept in whichever form (sentinel constant or raw ID)
+// the existing model used so that the two never produce a diff.
+func (c *commandPermissionsResourceModel) fromPermissions(permissions *discord.GuildApplicationCommandPermissions) {
+	guildID := permissions.GuildID
+	if guildID == "" {
+		guildID = c.GuildID.ValueString()
+	}
+
+	configuredIDs := map[string]string{}
+	for _, permission := range c.Permissions {
+		id, err := discord.ResolvePermissionID(guildID, permission.ID.ValueString())
+		if err != nil {
+			continue
+		}
+		configuredIDs[permission.Type.ValueString()+"/"+id] = permission.ID.ValueString()
+	}
+
+	c.ApplicationID = NewSnowflakeValue(permissions.ApplicationID)
+	c.GuildID = NewSnowflakeValue(guildID)
+	c.CommandID = NewSnowflakeValue(permissions.ID)
+	c.Permissions = []commandPermissionModel{}
+	for _, permission := range permissions.Permissions {
+		typeName := permissionTypeName(permission.Type)
+
+		id, ok := configuredIDs[typeName+"/"+permission.ID]
+		if !ok {
+			id = permission.ID
+			if sentinel := discord.SentinelName(guildID, permission); sentinel != "" {
+				id = sentinel
+			}
+		}
+
+		c.Permissions = append(c.Permissions, commandPermissionModel{
+			ID:         types.StringValue(id),
+			Type:       types.StringValue(typeName),
+			Permission: types.BoolValue(permission.Permission),
+		})
+	}
+	c.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+}
+
+// apply overwrites the command's permissions with those in the model and refreshes the model from the response.
+func (c *commandPermissionsResourceModel) apply(ctx context.Context, client *discord.Client, diags *diag.Diagnostics) {
+	if !client.HasBearerToken() {
+		diags.AddError(
+			"Missing Discord Bearer Token",
+			"Discord only allows command permissions to be edited with a user Bearer token. Set bearer_token in the provider configuration or the DISCORD_APPLICATION_BEARER_TOKEN environment variable",
+		)
+		return
+	}
+
+	editPermissions, err := c.toRequest()
+	if err != nil {
+		diags.AddError(
+			"Error Editing Discord Application Command Permissions",
+			"Could not build command permissions: "+err.Error(),
+		)
+		return
+	}
+
+	permissions, apiResponse, err := client.EditCommandPermissions(ctx, c.ApplicationID.ValueString(), c.GuildID.ValueString(), c.CommandID.ValueString(), editPermissions)
+	if err != nil {
+		diags.AddError(
+			"Error Editing Discord Application Command Permissions",
+			"Could not edit command permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Error Editing Discord Application Command Permissions",
+			fmt.Sprintf("Could not edit command permissions of command ID %s, unexpected status code: %d", c.CommandID.ValueString(), apiResponse.StatusCode),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	c.fromPermissions(permissions)
+}
+
+type commandPermissionsResource struct {
+	client *discord.Client
+}
+
+func (c *commandPermissionsResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "discord-application_command_permissions"
+}
+
+func (c *commandPermissionsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Permission overwrites of a Discord application command within a guild. " +
+			"Editing command permissions requires the provider to be configured with a `bearer_token`.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that the command belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the guild the permissions apply to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"command_id": schema.StringAttribute{
+				CustomType: SnowflakeType{},
+				MarkdownDescription: "The ID of the command. Set to the `application_id` to manage the application-wide defaults " +
+					"applied to every command without their own overwrites",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the permissions were updated",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permission": schema.SetNestedBlock{
+				Description: "A permission overwrite for a role, user or channel",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The ID of the role, user or channel. Use `%s` to target every member of the guild "+
+								"and `%s` to target every channel", discord.PermissionEveryone, discord.PermissionAllChannels),
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the target - one of `role`, `user` or `channel`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("role", "user", "channel"),
+							},
+						},
+						"permission": schema.BoolAttribute{
+							Description: "Whether the target is allowed (true) or denied (false) use of the command",
+							Required:    true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func (c *commandPermissionsResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config commandPermissionsResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, permission := range config.Permissions {
+		if permission.ID.IsUnknown() || permission.Type.IsUnknown() {
+			continue
+		}
+
+		switch {
+		case permission.ID.ValueString() == discord.PermissionEveryone && permission.Type.ValueString() != "role":
+			response.Diagnostics.AddAttributeError(
+				path.Root("permission"),
+				"Invalid permission type",
+				fmt.Sprintf("%s can only be used with a permission of type role", discord.PermissionEveryone),
+			)
+		case permission.ID.ValueString() == discord.PermissionAllChannels && permission.Type.ValueString() != "channel":
+			response.Diagnostics.AddAttributeError(
+				path.Root("permission"),
+				"Invalid permission type",
+				fmt.Sprintf("%s can only be used with a permission of type channel", discord.PermissionAllChannels),
+			)
+		}
+	}
+}
+
+func (c *commandPermissionsResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	c.client = providerData.api
+}
+
+func (c *commandPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, "-")
+	if len(ids) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: application_id-guild_id-command_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), resource.ImportStateRequest{ID: ids[0]}, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("guild_id"), resource.ImportStateRequest{ID: ids[1]}, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("command_id"), resource.ImportStateRequest{ID: ids[2]}, resp)
+}
+
+func (c *commandPermissionsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan commandPermissionsResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.apply(ctx, c.client, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (c *commandPermissionsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state commandPermissionsResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed permissions from discord
+	permissions, apiResponse, err := c.client.GetCommandPermissions(ctx, state.ApplicationID.ValueString(), state.GuildID.ValueString(), state.CommandID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Command Permissions",
+			"Could not read Discord Application Command Permissions | Command ID: "+state.CommandID.ValueString()+" | Guild ID: "+state.GuildID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Discord responds with a 404 once a command has no overwrites left within the guild
+	if apiResponse.StatusCode == http.StatusNotFound {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Command Permissions",
+			"Could not read Discord Application Command Permissions | Command ID: "+state.CommandID.ValueString()+" | Guild ID: "+state.GuildID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromPermissions(permissions)
+
+	// Set refreshed state
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (c *commandPermissionsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan commandPermissionsResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.apply(ctx, c.client, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (c *commandPermissionsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state commandPermissionsResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Clear every overwrite on the command
+	_, apiResponse, err := c.client.EditCommandPermissions(ctx, state.ApplicationID.ValueString(), state.GuildID.ValueString(), state.CommandID.ValueString(), &discord.EditApplicationCommandPermissions{
+		Permissions: []discord.ApplicationCommandPermission{},
+	})
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Application Command Permissions",
+			"Could not delete command permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK && apiResponse.StatusCode != http.StatusNotFound {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Application Command Permissions",
+			"Could not delete Discord Application Command Permissions of command ID "+state.CommandID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+}
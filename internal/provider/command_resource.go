@@ -3,19 +3,27 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/MichaelFraser99/discord-application-sdk/discord/client"
-	"github.com/MichaelFraser99/discord-application-sdk/discord/model"
-	"github.com/MichaelFraser99/discord-application-sdk/discord/utils"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 )
 
 var (
-	_ resource.Resource = &commandResource{}
+	_ resource.Resource                   = &commandResource{}
+	_ resource.ResourceWithValidateConfig = &commandResource{}
+	_ resource.ResourceWithModifyPlan     = &commandResource{}
 )
 
 func NewCommandResource() resource.Resource {
@@ -23,25 +31,176 @@ func NewCommandResource() resource.Resource {
 }
 
 type commandResourceModel struct {
-	ApplicationID types.String `tfsdk:"application_id"`
-	CommandID     types.String `tfsdk:"command_id"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	Type          types.Int64  `tfsdk:"type"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
+	ApplicationID            SnowflakeValue         `tfsdk:"application_id"`
+	CommandID                SnowflakeValue         `tfsdk:"command_id"`
+	Name                     types.String           `tfsdk:"name"`
+	NameLocalizations        types.Map              `tfsdk:"name_localizations"`
+	Description              types.String           `tfsdk:"description"`
+	DescriptionLocalizations types.Map              `tfsdk:"description_localizations"`
+	Type                     types.Int64            `tfsdk:"type"`
+	Options                  types.List             `tfsdk:"option"`
+	DefaultMemberPermissions types.String           `tfsdk:"default_member_permissions"`
+	DmPermission             types.Bool             `tfsdk:"dm_permission"`
+	Nsfw                     types.Bool             `tfsdk:"nsfw"`
+	IntegrationTypes         types.Set              `tfsdk:"integration_types"`
+	Contexts                 types.Set              `tfsdk:"contexts"`
+	DefinitionJSON           CommandDefinitionValue `tfsdk:"definition_json"`
+	LastUpdated              types.String           `tfsdk:"last_updated"`
+	Timeouts                 timeouts.Value         `tfsdk:"timeouts"`
 }
 
-func (c *commandResourceModel) fromCommand(command *model.ApplicationCommand) {
-	c.ApplicationID = types.StringValue(command.ApplicationID)
-	c.CommandID = types.StringValue(command.ID)
+// usesDefinition reports whether the command is described by definition_json rather than the typed attributes.
+func (c *commandResourceModel) usesDefinition() bool {
+	return !c.DefinitionJSON.IsNull()
+}
+
+// toCommand builds the command described by either definition_json or the typed attributes.
+func (c *commandResourceModel) toCommand() (*discord.ApplicationCommand, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c.usesDefinition() {
+		command, err := parseCommandDefinition(c.DefinitionJSON.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("definition_json"), "Invalid command definition", "Could not parse definition_json as a Discord command: "+err.Error())
+			return nil, diags
+		}
+		if command.Type == 0 {
+			command.Type = 1
+		}
+		return command, diags
+	}
+
+	options := commandOptionsFromList(c.Options)
+	return &discord.ApplicationCommand{
+		Name:                     c.Name.ValueString(),
+		NameLocalizations:        localizationsFromMap(c.NameLocalizations),
+		Description:              c.Description.ValueString(),
+		DescriptionLocalizations: localizationsFromMap(c.DescriptionLocalizations),
+		Type:                     int(c.Type.ValueInt64()),
+		Options:                  &options,
+		DefaultMemberPermissions: knownStringPointer(c.DefaultMemberPermissions),
+		DmPermission:             knownBoolPointer(c.DmPermission),
+		Nsfw:                     knownBoolPointer(c.Nsfw),
+		IntegrationTypes:         intsFromSet(c.IntegrationTypes),
+		Contexts:                 intsFromSet(c.Contexts),
+	}, diags
+}
+
+func (c *commandResourceModel) fromCommand(command *discord.ApplicationCommand) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c.ApplicationID = NewSnowflakeValue(command.ApplicationID)
+	c.CommandID = NewSnowflakeValue(command.ID)
 	c.Name = types.StringValue(command.Name)
 	c.Description = types.StringValue(command.Description)
 	c.Type = types.Int64Value(int64(command.Type))
 	c.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	if c.usesDefinition() {
+		// The definition carries the options, so the option blocks stay empty
+		c.Options = types.ListValueMust(types.ObjectType{AttrTypes: commandOptionAttrTypes(1)}, []attr.Value{})
+		diags.Append(c.refreshDefinition(command)...)
+		return diags
+	}
+
+	diags.Append(c.refreshAttributes(command)...)
+
+	return diags
+}
+
+// refreshAttributes compares the live command with the typed attributes field by field once both are normalised, so
+// values Discord fills in with their defaults compare equal to being unset. Only the fields that really differ are
+// overwritten with the live values.
+func (c *commandResourceModel) refreshAttributes(command *discord.ApplicationCommand) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	live := normalizeCommand(*command)
+	desired, desiredDiags := c.toCommand()
+	diags.Append(desiredDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	differences, err := commandDifferences(*desired, live)
+	if err != nil {
+		diags.AddError("Error Comparing Discord Application Command", "Could not compare command with its configuration: "+err.Error())
+		return diags
+	}
+	changed := map[string]bool{}
+	for _, field := range differences {
+		changed[field] = true
+	}
+
+	if changed["name"] || c.Name.IsNull() || c.Name.IsUnknown() {
+		c.Name = types.StringValue(live.Name)
+	}
+	if changed["description"] || c.Description.IsNull() || c.Description.IsUnknown() {
+		c.Description = types.StringValue(live.Description)
+	}
+	if changed["type"] || c.Type.IsNull() || c.Type.IsUnknown() {
+		c.Type = types.Int64Value(int64(live.Type))
+	}
+	if changed["name_localizations"] {
+		c.NameLocalizations = localizationsToMap(live.NameLocalizations)
+	}
+	if changed["description_localizations"] {
+		c.DescriptionLocalizations = localizationsToMap(live.DescriptionLocalizations)
+	}
+	if changed["options"] || c.Options.IsNull() || c.Options.IsUnknown() {
+		options, optionDiags := commandOptionsToList(live.Options, 1)
+		diags.Append(optionDiags...)
+		c.Options = options
+	}
+	if changed["default_member_permissions"] {
+		c.DefaultMemberPermissions = types.StringPointerValue(live.DefaultMemberPermissions)
+	}
+	if changed["dm_permission"] {
+		c.DmPermission = types.BoolPointerValue(live.DmPermission)
+	}
+	if changed["nsfw"] {
+		c.Nsfw = types.BoolPointerValue(live.Nsfw)
+	}
+	if changed["integration_types"] {
+		c.IntegrationTypes = intsToSet(live.IntegrationTypes)
+	}
+	if changed["contexts"] {
+		c.Contexts = intsToSet(live.Contexts)
+	}
+
+	return diags
+}
+
+// refreshDefinition compares the live command with definition_json field by field. The definition is left untouched
+// when the two are semantically equal, otherwise it is replaced with the live command so the drift shows up in the plan.
+func (c *commandResourceModel) refreshDefinition(command *discord.ApplicationCommand) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if definition, err := parseCommandDefinition(c.DefinitionJSON.ValueString()); err == nil {
+		differences, err := commandDifferences(*definition, *command)
+		if err == nil && len(differences) == 0 {
+			return diags
+		}
+		if err == nil {
+			diags.AddWarning(
+				"Discord Application Command Drift",
+				fmt.Sprintf("Command %s differs from definition_json in: %s", command.ID, strings.Join(differences, ", ")),
+			)
+		}
+	}
+
+	live, err := commandDefinitionJSON(*command)
+	if err != nil {
+		diags.AddError("Error Rendering Discord Application Command", "Could not render command as JSON: "+err.Error())
+		return diags
+	}
+	c.DefinitionJSON = NewCommandDefinitionValue(live)
+
+	return diags
 }
 
 type commandResource struct {
-	client *client.Client
+	client   *discord.Client
+	commands *commandCache
 }
 
 //todo: implement rest of commands api
@@ -56,30 +215,177 @@ func (c *commandResource) Schema(ctx context.Context, request resource.SchemaReq
 		MarkdownDescription: "Discord application command",
 		Attributes: map[string]schema.Attribute{
 			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
 				Description: "The application ID that the command belongs to",
 				Required:    true,
 			},
 			"command_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
 				Description: "The ID of the command",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the command - matches the command a user would type in discord",
-				Required:    true,
+				Description: "The name of the command - matches the command a user would type in discord. Required unless definition_json is set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"description": schema.StringAttribute{
-				Description: "The description of the command - displayed in discord",
-				Required:    true,
+				Description: "The description of the command - displayed in discord. Required unless definition_json is set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.Int64Attribute{
-				Description: "The type of command - see discord application API documentation for more info",
-				Required:    true,
+				Description: "The type of command - see discord application API documentation for more info. Required unless definition_json is set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name_localizations": schema.MapAttribute{
+				Description: "Localized names of the command, keyed by Discord locale",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"description_localizations": schema.MapAttribute{
+				Description: "Localized descriptions of the command, keyed by Discord locale",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_member_permissions": schema.StringAttribute{
+				Description: "The permission bitfield a member needs to use the command by default. \"0\" limits the command to administrators, unset makes it available to everyone",
+				Optional:    true,
+			},
+			"dm_permission": schema.BoolAttribute{
+				Description: "Whether the command is available in DMs with the app. Defaults to true",
+				Optional:    true,
+			},
+			"nsfw": schema.BoolAttribute{
+				Description: "Whether the command is age-restricted. Defaults to false",
+				Optional:    true,
+			},
+			"integration_types": schema.SetAttribute{
+				Description: "The installation contexts the command is available in - 0 for guild installs and 1 for user installs. Defaults to guild installs only",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"contexts": schema.SetAttribute{
+				Description: "The interaction contexts the command can be used in - 0 for guilds, 1 for the bot's DMs and 2 for private channels",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"definition_json": schema.StringAttribute{
+				MarkdownDescription: "A complete Discord command JSON object, as sent to `PUT /applications/{id}/commands` or exported by discord.js and discord.py. " +
+					"An alternative to `name`, `description`, `type` and `option` which compares semantically, so key order and defaults never produce a diff",
+				CustomType: CommandDefinitionType{},
+				Optional:   true,
 			},
 			"last_updated": schema.StringAttribute{
 				Description: "The last time the command was updated",
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"option": commandOptionBlock(1),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans name, description and type from definition_json when the command is described by it, so they are
+// only shown as changing when the definition changes them.
+func (c *commandResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan commandResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() || !plan.usesDefinition() {
+		return
+	}
+
+	if plan.DefinitionJSON.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("name"), types.StringUnknown())...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("description"), types.StringUnknown())...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("type"), types.Int64Unknown())...)
+		return
+	}
+
+	command, err := parseCommandDefinition(plan.DefinitionJSON.ValueString())
+	if err != nil {
+		return
+	}
+	normalized := normalizeCommand(*command)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("name"), types.StringValue(normalized.Name))...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("description"), types.StringValue(normalized.Description))...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("type"), types.Int64Value(int64(normalized.Type)))...)
+}
+
+func (c *commandResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config commandResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.usesDefinition() {
+		for name, value := range map[string]attr.Value{"name": config.Name, "description": config.Description, "type": config.Type} {
+			if value.IsNull() {
+				response.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Missing Attribute Configuration",
+					name+" must be configured unless definition_json is set",
+				)
+			}
+		}
+
+		validateCommandOptions(config.Options, path.Root("option"), &response.Diagnostics)
+		return
+	}
+
+	for name, value := range map[string]attr.Value{
+		"name":                       config.Name,
+		"name_localizations":         config.NameLocalizations,
+		"description":                config.Description,
+		"description_localizations":  config.DescriptionLocalizations,
+		"type":                       config.Type,
+		"default_member_permissions": config.DefaultMemberPermissions,
+		"dm_permission":              config.DmPermission,
+		"nsfw":                       config.Nsfw,
+		"integration_types":          config.IntegrationTypes,
+		"contexts":                   config.Contexts,
+	} {
+		if !value.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				name+" cannot be configured alongside definition_json",
+			)
+		}
+	}
+	if len(config.Options.Elements()) > 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("option"),
+			"Invalid Attribute Combination",
+			"option blocks cannot be configured alongside definition_json",
+		)
+	}
+
+	if !config.DefinitionJSON.IsUnknown() {
+		validateCommandDefinition(config.DefinitionJSON.ValueString(), path.Root("definition_json"), &response.Diagnostics)
 	}
 }
 
@@ -88,18 +394,35 @@ func (c *commandResource) Configure(ctx context.Context, request resource.Config
 		return
 	}
 
-	client, ok := request.ProviderData.(*client.Client)
+	providerData, ok := request.ProviderData.(*discordProviderData)
 
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
 		)
 
 		return
 	}
 
-	c.client = client
+	c.client = providerData.api
+	c.commands = providerData.commands
+}
+
+// getCommand fetches a command from the cached commands of its application. Commands missing from the cache, such as
+// ones created outside of terraform since it was filled, are fetched directly so a missing command reports a 404.
+// A nil http.Response means the command was served from the cache.
+func (c *commandResource) getCommand(ctx context.Context, applicationID, commandID string) (*discord.ApplicationCommand, *http.Response, error) {
+	commands, apiResponse, err := c.commands.list(ctx, c.client, applicationID)
+	if err != nil || (apiResponse != nil && apiResponse.StatusCode != http.StatusOK) {
+		return nil, apiResponse, err
+	}
+
+	if command, ok := commands[commandID]; ok {
+		return &command, nil, nil
+	}
+
+	return c.client.GetCommand(ctx, applicationID, commandID)
 }
 
 func (c *commandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -117,15 +440,44 @@ func (c *commandResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
-	createApplicationCommand := &model.CreateApplicationCommand{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-		Type:        utils.Int(int(plan.Type.ValueInt64())),
+	desired, diags := plan.toCommand()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createApplicationCommand := &discord.CreateApplicationCommand{
+		Name:                     desired.Name,
+		NameLocalizations:        desired.NameLocalizations,
+		Description:              desired.Description,
+		DescriptionLocalizations: desired.DescriptionLocalizations,
+		DefaultMemberPermissions: desired.DefaultMemberPermissions,
+		DmPermission:             desired.DmPermission,
+		Type:                     &desired.Type,
+		Nsfw:                     desired.Nsfw,
+		IntegrationTypes:         desired.IntegrationTypes,
+		Contexts:                 desired.Contexts,
+	}
+	if desired.Options != nil && len(*desired.Options) > 0 {
+		createApplicationCommand.Options = desired.Options
 	}
 
 	// Create new command
-	command, sdkResponse, err := c.client.ApplicationCommand.CreateCommand(ctx, plan.ApplicationID.ValueString(), createApplicationCommand)
+	command, apiResponse, err := c.client.CreateCommand(ctx, plan.ApplicationID.ValueString(), createApplicationCommand)
+	c.commands.invalidate(plan.ApplicationID.ValueString())
+	if addTimeoutError(ctx, err, "Discord Application Command", "create", createTimeout, &response.Diagnostics) {
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error creating command",
@@ -134,16 +486,20 @@ func (c *commandResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	if sdkResponse.StatusCode != 201 && sdkResponse.StatusCode != 200 {
+	if apiResponse.StatusCode != 201 && apiResponse.StatusCode != 200 {
 		response.Diagnostics.AddError(
 			"Error creating command",
-			fmt.Sprintf("Could not create command, unexpected status code: %d", sdkResponse.StatusCode),
+			fmt.Sprintf("Could not create command, unexpected status code: %d", apiResponse.StatusCode),
 		)
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.fromCommand(command)
+	diags = plan.fromCommand(command)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	diags = response.State.Set(ctx, plan)
@@ -161,8 +517,20 @@ func (c *commandResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed command value from discord
-	command, sdkResponse, err := c.client.ApplicationCommand.GetCommand(ctx, state.ApplicationID.ValueString(), state.CommandID.ValueString())
+	command, apiResponse, err := c.getCommand(ctx, state.ApplicationID.ValueString(), state.CommandID.ValueString())
+	if addTimeoutError(ctx, err, "Discord Application Command", "read", readTimeout, &response.Diagnostics) {
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error Reading Discord Application Command",
@@ -171,16 +539,20 @@ func (c *commandResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
-	if sdkResponse.StatusCode != 200 {
+	if apiResponse != nil && apiResponse.StatusCode != 200 {
 		response.Diagnostics.AddError(
 			"Error Reading Discord Application Command",
-			"Could not read Discord Application Command | ID: "+state.CommandID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+": "+sdkResponse.Status,
+			"Could not read Discord Application Command | ID: "+state.CommandID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
 		)
 		return
 	}
 
 	// Overwrite items with refreshed state
-	state.fromCommand(command)
+	diags = state.fromCommand(command)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = response.State.Set(ctx, &state)
@@ -206,14 +578,66 @@ func (c *commandResource) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
-	command := model.PatchApplicationCommand{
-		Name:        plan.Name.ValueStringPointer(),
-		Description: plan.Description.ValueStringPointer(),
+	desired, diags := plan.toCommand()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Unset fields are sent with their defaults so that removing them from the configuration resets them in discord
+	options := []discord.ApplicationCommandOption{}
+	if desired.Options != nil {
+		options = *desired.Options
+	}
+	if desired.NameLocalizations == nil {
+		desired.NameLocalizations = &map[string]string{}
+	}
+	if desired.DescriptionLocalizations == nil {
+		desired.DescriptionLocalizations = &map[string]string{}
+	}
+	if desired.DmPermission == nil {
+		desired.DmPermission = boolPointer(true)
+	}
+	if desired.Nsfw == nil {
+		desired.Nsfw = boolPointer(false)
+	}
+	if desired.IntegrationTypes == nil {
+		integrationTypes := slices.Clone(defaultCommandIntegrationTypes)
+		desired.IntegrationTypes = &integrationTypes
+	}
+	if desired.Contexts == nil {
+		contexts := slices.Clone(defaultCommandContexts)
+		desired.Contexts = &contexts
+	}
+	command := discord.PatchApplicationCommand{
+		Name:                     &desired.Name,
+		NameLocalizations:        desired.NameLocalizations,
+		Description:              &desired.Description,
+		DescriptionLocalizations: desired.DescriptionLocalizations,
+		Options:                  &options,
+		DefaultMemberPermissions: desired.DefaultMemberPermissions,
+		DmPermission:             desired.DmPermission,
+		Nsfw:                     desired.Nsfw,
+		IntegrationTypes:         desired.IntegrationTypes,
+		Contexts:                 desired.Contexts,
 	}
 
 	// Update existing command
-	updatedCommand, sdkResponse, err := c.client.ApplicationCommand.PatchCommand(ctx, plan.ApplicationID.ValueString(), state.CommandID.ValueString(), &command)
+	updatedCommand, apiResponse, err := c.client.PatchCommand(ctx, plan.ApplicationID.ValueString(), state.CommandID.ValueString(), &command)
+	c.commands.invalidate(plan.ApplicationID.ValueString())
+	if addTimeoutError(ctx, err, "Discord Application Command", "update", updateTimeout, &response.Diagnostics) {
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error Updating Discord Application Command",
@@ -222,16 +646,20 @@ func (c *commandResource) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
-	if sdkResponse.StatusCode != 200 {
+	if apiResponse.StatusCode != 200 {
 		response.Diagnostics.AddError(
 			"Error Updating Discord Application Command",
-			"Could not update Discord Application Command ID: "+plan.CommandID.ValueString()+": "+sdkResponse.Status,
+			"Could not update Discord Application Command ID: "+plan.CommandID.ValueString()+": "+apiResponse.Status,
 		)
 		return
 	}
 
 	// Update resource state with updated items and timestamp
-	plan.fromCommand(updatedCommand)
+	diags = plan.fromCommand(updatedCommand)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
@@ -248,8 +676,21 @@ func (c *commandResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing order
-	sdkResponse, err := c.client.ApplicationCommand.DeleteCommand(ctx, state.ApplicationID.ValueString(), state.CommandID.ValueString())
+	apiResponse, err := c.client.DeleteCommand(ctx, state.ApplicationID.ValueString(), state.CommandID.ValueString())
+	c.commands.invalidate(state.ApplicationID.ValueString())
+	if addTimeoutError(ctx, err, "Discord Application Command", "delete", deleteTimeout, &response.Diagnostics) {
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error Deleting Discord Application Command",
@@ -258,10 +699,10 @@ func (c *commandResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
-	if sdkResponse.StatusCode != 204 {
+	if apiResponse.StatusCode != 204 {
 		response.Diagnostics.AddError(
 			"Error Deleting Discord Application Command",
-			"Could not delete Discord Application Command ID "+state.CommandID.ValueString()+": "+sdkResponse.Status,
+			"Could not delete Discord Application Command ID "+state.CommandID.ValueString()+": "+apiResponse.Status,
 		)
 		return
 	}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func newCommandPlan(t *testing.T, values map[string]any) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResponse resource.SchemaResponse
+	(&commandResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range values {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("could not set %s: %v", name, diags)
+		}
+	}
+	return plan
+}
+
+func TestCommandResourceModifyPlanDefinition(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		definition      CommandDefinitionValue
+		wantName        types.String
+		wantDescription types.String
+		wantType        types.Int64
+	}{
+		{
+			name:            "chat input",
+			definition:      NewCommandDefinitionValue(`{"name":"ban","description":"Ban a member"}`),
+			wantName:        types.StringValue("ban"),
+			wantDescription: types.StringValue("Ban a member"),
+			wantType:        types.Int64Value(1),
+		},
+		{
+			name:            "user command",
+			definition:      NewCommandDefinitionValue(`{"name":"Report","type":2}`),
+			wantName:        types.StringValue("Report"),
+			wantDescription: types.StringValue(""),
+			wantType:        types.Int64Value(2),
+		},
+		{
+			name:            "unknown definition",
+			definition:      CommandDefinitionValue{StringValue: types.StringUnknown()},
+			wantName:        types.StringUnknown(),
+			wantDescription: types.StringUnknown(),
+			wantType:        types.Int64Unknown(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := newCommandPlan(t, map[string]any{
+				"application_id":  NewSnowflakeValue("1234567890987654321"),
+				"definition_json": test.definition,
+				"name":            types.StringValue("stale"),
+				"description":     types.StringValue("stale"),
+				"type":            types.Int64Value(3),
+			})
+			request := resource.ModifyPlanRequest{Plan: plan}
+			response := resource.ModifyPlanResponse{Plan: plan}
+
+			(&commandResource{}).ModifyPlan(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			var got commandResourceModel
+			if diags := response.Plan.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("could not read plan: %v", diags)
+			}
+			if !got.Name.Equal(test.wantName) || !got.Description.Equal(test.wantDescription) || !got.Type.Equal(test.wantType) {
+				t.Errorf("expected %s %s %s, got %s %s %s", test.wantName, test.wantDescription, test.wantType, got.Name, got.Description, got.Type)
+			}
+		})
+	}
+}
+
+func TestCommandResourceUpdateResetsRemovedInstallContexts(t *testing.T) {
+	ctx := context.Background()
+
+	var sent discord.PatchApplicationCommand
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || !strings.HasSuffix(r.URL.Path, "/applications/1234567890987654321/commands/1111111111111111111") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Errorf("could not decode request body: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1111111111111111111","application_id":"1234567890987654321","type":1,"name":"ban",
+			"description":"Ban a member","dm_permission":true,"nsfw":false,"integration_types":[0],"contexts":[0,1,2]}`))
+	}))
+	defer server.Close()
+
+	r := &commandResource{
+		client:   discord.NewClient(&discord.Config{Token: "token", BaseUrl: server.URL, HTTPClient: server.Client()}),
+		commands: newCommandCache(),
+	}
+
+	values := map[string]any{
+		"application_id": NewSnowflakeValue("1234567890987654321"),
+		"command_id":     NewSnowflakeValue("1111111111111111111"),
+		"name":           types.StringValue("ban"),
+		"description":    types.StringValue("Ban a member"),
+		"type":           types.Int64Value(1),
+	}
+	plan := newCommandPlan(t, values)
+
+	values["integration_types"] = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(0), types.Int64Value(1)})
+	values["contexts"] = types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(0)})
+	prior := newCommandPlan(t, values)
+
+	request := resource.UpdateRequest{Plan: plan, State: tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}}
+	response := resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Update(ctx, request, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	if sent.IntegrationTypes == nil || !slices.Equal(*sent.IntegrationTypes, []int{0}) {
+		t.Errorf("expected integration_types to be reset to [0], sent %v", sent.IntegrationTypes)
+	}
+	if sent.Contexts == nil || !slices.Equal(*sent.Contexts, []int{0, 1, 2}) {
+		t.Errorf("expected contexts to be reset to [0 1 2], sent %v", sent.Contexts)
+	}
+
+	var state commandResourceModel
+	if diags := response.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("could not read state: %v", diags)
+	}
+	if !state.IntegrationTypes.IsNull() || !state.Contexts.IsNull() {
+		t.Errorf("expected the removed attributes to stay unset, got %s and %s", state.IntegrationTypes, state.Contexts)
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// validateCredentials checks the configured tokens against discord, so that a revoked token fails when the provider
+// is configured rather than part way through an apply. The application each token belongs to is compared with the
+// expected application ID when one is configured.
+func validateCredentials(ctx context.Context, client *discord.Client, expectedApplicationID string, diags *diag.Diagnostics) {
+	user, apiResponse, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Validate Discord API Token",
+			"Could not read the bot user of the token: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode == http.StatusUnauthorized {
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Invalid Discord API Token",
+			"Discord rejected the token as unauthorized. Check the token has not been reset or revoked in the developer portal, "+
+				"or set skip_credentials_validation to skip this check",
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Unable to Validate Discord API Token",
+			"Could not read the bot user of the token: "+apiResponse.Status,
+		)
+		return
+	}
+
+	application, apiResponse, err := client.GetCurrentApplication(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Validate Discord API Token",
+			"Could not read the application of the token: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Unable to Validate Discord API Token",
+			"Could not read the application of the token: "+apiResponse.Status,
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Authenticated with Discord bot token", map[string]any{
+		"bot_username":   user.Username,
+		"bot_user_id":    user.ID,
+		"application_id": application.ID,
+	})
+	warnApplicationMismatch(path.Root("token"), "token", application.ID, expectedApplicationID, diags)
+
+	if !client.HasBearerToken() {
+		return
+	}
+
+	authorization, apiResponse, err := client.GetCurrentAuthorization(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Validate Discord API Bearer Token",
+			"Could not read the authorization of the Bearer token: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode == http.StatusUnauthorized {
+		diags.AddAttributeError(
+			path.Root("bearer_token"),
+			"Invalid Discord API Bearer Token",
+			"Discord rejected the Bearer token as unauthorized. Check the token has not expired or been revoked, "+
+				"or set skip_credentials_validation to skip this check",
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Unable to Validate Discord API Bearer Token",
+			"Could not read the authorization of the Bearer token: "+apiResponse.Status,
+		)
+		return
+	}
+
+	fields := map[string]any{
+		"scopes":  authorization.Scopes,
+		"expires": authorization.Expires,
+	}
+	if authorization.User != nil {
+		fields["username"] = authorization.User.Username
+		fields["user_id"] = authorization.User.ID
+	}
+	if authorization.Application != nil {
+		fields["application_id"] = authorization.Application.ID
+		warnApplicationMismatch(path.Root("bearer_token"), "Bearer token", authorization.Application.ID, expectedApplicationID, diags)
+	}
+	tflog.Info(ctx, "Authenticated with Discord Bearer token", fields)
+}
+
+func warnApplicationMismatch(attributePath path.Path, token, applicationID, expectedApplicationID string, diags *diag.Diagnostics) {
+	if expectedApplicationID == "" || applicationID == expectedApplicationID {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		attributePath,
+		"Mismatched Discord Application",
+		fmt.Sprintf("The %s belongs to application ID %s but the provider is configured with application ID %s. "+
+			"Resources will be managed with the credentials of application ID %s", token, applicationID, expectedApplicationID, applicationID),
+	)
+}
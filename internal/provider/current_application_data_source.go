@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+var (
+	_ datasource.DataSource              = &currentApplicationDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentApplicationDataSource{}
+)
+
+func NewCurrentApplicationDataSource() datasource.DataSource {
+	return &currentApplicationDataSource{}
+}
+
+type currentApplicationDataSourceModel struct {
+	ID                    SnowflakeValue `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Description           types.String   `tfsdk:"description"`
+	VerifyKey             types.String   `tfsdk:"verify_key"`
+	BotUserID             SnowflakeValue `tfsdk:"bot_user_id"`
+	OwnerID               SnowflakeValue `tfsdk:"owner_id"`
+	TeamID                SnowflakeValue `tfsdk:"team_id"`
+	Flags                 types.Int64    `tfsdk:"flags"`
+	ApproximateGuildCount types.Int64    `tfsdk:"approximate_guild_count"`
+	RedirectURIs          types.List     `tfsdk:"redirect_uris"`
+}
+
+func (c *currentApplicationDataSourceModel) fromApplication(ctx context.Context, application *discord.Application) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c.ID = NewSnowflakeValue(application.ID)
+	c.Name = types.StringValue(application.Name)
+	c.Description = types.StringValue(application.Description)
+	c.VerifyKey = types.StringValue(application.VerifyKey)
+	c.Flags = types.Int64Value(int64(application.Flags))
+	c.ApproximateGuildCount = intPointerValue(application.ApproximateGuildCount)
+
+	c.BotUserID = NewSnowflakeNull()
+	if application.Bot != nil {
+		c.BotUserID = NewSnowflakeValue(application.Bot.ID)
+	}
+	c.OwnerID = NewSnowflakeNull()
+	if application.Owner != nil {
+		c.OwnerID = NewSnowflakeValue(application.Owner.ID)
+	}
+	c.TeamID = NewSnowflakeNull()
+	if application.Team != nil {
+		c.TeamID = NewSnowflakeValue(application.Team.ID)
+	}
+
+	redirectURIs := []string{}
+	if application.RedirectURIs != nil {
+		redirectURIs = *application.RedirectURIs
+	}
+	var listDiags diag.Diagnostics
+	c.RedirectURIs, listDiags = types.ListValueFrom(ctx, types.StringType, redirectURIs)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+type currentApplicationDataSource struct {
+	client *discord.Client
+}
+
+func (c *currentApplicationDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_current_application"
+}
+
+func (c *currentApplicationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The Discord application the provider token belongs to",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the application",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the application",
+				Computed:    true,
+			},
+			"verify_key": schema.StringAttribute{
+				Description: "The hex encoded public key used to verify the signatures of interaction requests",
+				Computed:    true,
+			},
+			"bot_user_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The user ID of the application's bot, null when the application has no bot",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The user ID of the application's owner. For team owned applications this is the ID of a placeholder user representing the team",
+				Computed:    true,
+			},
+			"team_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the team owning the application, null when owned by a single user",
+				Computed:    true,
+			},
+			"flags": schema.Int64Attribute{
+				Description: "The public flags of the application as a bitfield",
+				Computed:    true,
+			},
+			"approximate_guild_count": schema.Int64Attribute{
+				Description: "The approximate number of guilds the application is installed to",
+				Computed:    true,
+			},
+			"redirect_uris": schema.ListAttribute{
+				Description: "The OAuth2 redirect URIs of the application",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (c *currentApplicationDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	c.client = providerData.api
+}
+
+func (c *currentApplicationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state currentApplicationDataSourceModel
+	diags := request.Config.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	application, apiResponse, err := c.client.GetCurrentApplication(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application",
+			"Could not read Discord Application | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application",
+			"Could not read Discord Application: "+apiResponse.Status,
+		)
+		return
+	}
+
+	diags = state.fromApplication(ctx, application)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
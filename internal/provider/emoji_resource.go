@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	_ resource.Resource                = &emojiResource{}
+	_ resource.ResourceWithConfigure   = &emojiResource{}
+	_ resource.ResourceWithImportState = &emojiResource{}
+	_ resource.ResourceWithModifyPlan  = &emojiResource{}
+)
+
+// maxEmojiSize is the largest image Discord accepts for an emoji.
+const maxEmojiSize = 256 * 1024
+
+var emojiMediaTypes = []string{mediaTypePNG, mediaTypeJPEG, mediaTypeGIF, mediaTypeWebP}
+
+var emojiNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{2,32}$`)
+
+func NewEmojiResource() resource.Resource {
+	return &emojiResource{}
+}
+
+type emojiResourceModel struct {
+	ApplicationID SnowflakeValue `tfsdk:"application_id"`
+	EmojiID       SnowflakeValue `tfsdk:"emoji_id"`
+	Name          types.String   `tfsdk:"name"`
+	Source        types.String   `tfsdk:"source"`
+	SourceHash    types.String   `tfsdk:"source_hash"`
+	Animated      types.Bool     `tfsdk:"animated"`
+	Markup        types.String   `tfsdk:"markup"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+}
+
+func (e *emojiResourceModel) fromEmoji(emoji *discord.Emoji) {
+	e.EmojiID = NewSnowflakeValue(emoji.ID)
+	e.Name = types.StringValue(emoji.Name)
+	e.Animated = types.BoolValue(emoji.Animated)
+	e.Markup = types.StringValue(emoji.Markup())
+	e.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+}
+
+type emojiResource struct {
+	client *discord.Client
+}
+
+func (e *emojiResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "discord-application_emoji"
+}
+
+func (e *emojiResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "An emoji owned by a Discord application, usable by its bot in any guild. " +
+			"The emoji is replaced whenever the content of its image changes.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that owns the emoji",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emoji_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the emoji",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the emoji, 2 to 32 alphanumeric or underscore characters. Renaming keeps the emoji",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emojiNamePattern, "must be 2 to 32 alphanumeric or underscore characters"),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The emoji image as a local file path, base64 encoded content or a data URI. "+
+					"PNG, JPEG, GIF and WebP images up to %d KiB are accepted", maxEmojiSize/1024),
+				Required: true,
+			},
+			"source_hash": schema.StringAttribute{
+				Description: "The SHA-256 of the image content, used to replace the emoji when the image changes",
+				Computed:    true,
+			},
+			"animated": schema.BoolAttribute{
+				Description: "Whether the emoji is animated",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"markup": schema.StringAttribute{
+				MarkdownDescription: "The message markup which renders the emoji, such as `<:wave:1234567890987654321>`",
+				Computed:            true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the emoji was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan hashes the image behind the source so that changing the file content, not only the source attribute,
+// replaces the emoji.
+func (e *emojiResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan emojiResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || plan.Source.IsUnknown() {
+		return
+	}
+
+	image, err := loadImage(plan.Source.ValueString(), emojiMediaTypes, maxEmojiSize)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Emoji Image", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("source_hash"), types.StringValue(image.Hash()))...)
+
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var state emojiResourceModel
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported emojis have no known hash, so their image is adopted as is
+	if !state.SourceHash.IsNull() && state.SourceHash.ValueString() != image.Hash() {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root("source_hash"))
+	}
+
+	if state.Name.ValueString() == plan.Name.ValueString() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("markup"), state.Markup)...)
+	}
+}
+
+func (e *emojiResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = providerData.api
+}
+
+func (e *emojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, "-")
+	if len(ids) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: application_id-emoji_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), resource.ImportStateRequest{ID: ids[0]}, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("emoji_id"), resource.ImportStateRequest{ID: ids[1]}, resp)
+}
+
+func (e *emojiResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan emojiResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := loadImage(plan.Source.ValueString(), emojiMediaTypes, maxEmojiSize)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Emoji Image", err.Error())
+		return
+	}
+
+	// Upload new emoji
+	emoji, apiResponse, err := e.client.CreateEmoji(ctx, plan.ApplicationID.ValueString(), &discord.CreateApplicationEmoji{
+		Name:  plan.Name.ValueString(),
+		Image: image.DataURI(),
+	})
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error creating emoji",
+			"Could not create emoji, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusCreated && apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error creating emoji",
+			fmt.Sprintf("Could not create emoji, unexpected status code: %d", apiResponse.StatusCode),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromEmoji(emoji)
+	plan.SourceHash = types.StringValue(image.Hash())
+
+	// Set state to fully populated data
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *emojiResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state emojiResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed emoji value from discord
+	emoji, apiResponse, err := e.client.GetEmoji(ctx, state.ApplicationID.ValueString(), state.EmojiID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Emoji",
+			"Could not read Discord Application Emoji | ID: "+state.EmojiID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode == http.StatusNotFound {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Emoji",
+			"Could not read Discord Application Emoji | ID: "+state.EmojiID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromEmoji(emoji)
+
+	// Set refreshed state
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *emojiResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state emojiResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan emojiResourceModel
+	diags = request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the name can be edited, image changes replace the emoji
+	emoji, apiResponse, err := e.client.PatchEmoji(ctx, plan.ApplicationID.ValueString(), state.EmojiID.ValueString(), &discord.PatchApplicationEmoji{
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Updating Discord Application Emoji",
+			"Could not update Discord Application Emoji ID: "+state.EmojiID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Updating Discord Application Emoji",
+			"Could not update Discord Application Emoji ID: "+state.EmojiID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.fromEmoji(emoji)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *emojiResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state emojiResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponse, err := e.client.DeleteEmoji(ctx, state.ApplicationID.ValueString(), state.EmojiID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Application Emoji",
+			"Could not delete emoji, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusNoContent && apiResponse.StatusCode != http.StatusNotFound {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Application Emoji",
+			"Could not delete Discord Application Emoji ID "+state.EmojiID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+}
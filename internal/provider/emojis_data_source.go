@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+var (
+	_ datasource.DataSource              = &emojisDataSource{}
+	_ datasource.DataSourceWithConfigure = &emojisDataSource{}
+)
+
+func NewEmojisDataSource() datasource.DataSource {
+	return &emojisDataSource{}
+}
+
+type emojisDataSourceModel struct {
+	ApplicationID SnowflakeValue    `tfsdk:"application_id"`
+	Emojis        []emojiItemModel  `tfsdk:"emojis"`
+	Markup        map[string]string `tfsdk:"markup"`
+}
+
+type emojiItemModel struct {
+	ID        SnowflakeValue `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Animated  types.Bool     `tfsdk:"animated"`
+	Available types.Bool     `tfsdk:"available"`
+	Markup    types.String   `tfsdk:"markup"`
+}
+
+func (e *emojisDataSourceModel) fromEmojis(emojis []discord.Emoji) {
+	e.Emojis = []emojiItemModel{}
+	e.Markup = map[string]string{}
+	for _, emoji := range emojis {
+		e.Emojis = append(e.Emojis, emojiItemModel{
+			ID:        NewSnowflakeValue(emoji.ID),
+			Name:      types.StringValue(emoji.Name),
+			Animated:  types.BoolValue(emoji.Animated),
+			Available: types.BoolValue(emoji.Available),
+			Markup:    types.StringValue(emoji.Markup()),
+		})
+		e.Markup[emoji.Name] = emoji.Markup()
+	}
+}
+
+type emojisDataSource struct {
+	client *discord.Client
+}
+
+func (e *emojisDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_emojis"
+}
+
+func (e *emojisDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Every emoji owned by a Discord application",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that owns the emojis",
+				Required:    true,
+			},
+			"emojis": schema.ListNestedAttribute{
+				Description: "The emojis owned by the application",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the emoji",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the emoji",
+							Computed:    true,
+						},
+						"animated": schema.BoolAttribute{
+							Description: "Whether the emoji is animated",
+							Computed:    true,
+						},
+						"available": schema.BoolAttribute{
+							Description: "Whether the emoji can currently be used",
+							Computed:    true,
+						},
+						"markup": schema.StringAttribute{
+							MarkdownDescription: "The message markup which renders the emoji, such as `<:wave:1234567890987654321>`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"markup": schema.MapAttribute{
+				Description: "The message markup of every emoji keyed by emoji name",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *emojisDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = providerData.api
+}
+
+func (e *emojisDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state emojisDataSourceModel
+	diags := request.Config.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	emojis, apiResponse, err := e.client.GetEmojis(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Emojis",
+			"Could not read Discord Application Emojis | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Emojis",
+			"Could not read Discord Application Emojis | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	if emojis == nil {
+		emojis = &discord.ApplicationEmojis{}
+	}
+	state.fromEmojis(emojis.Items)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
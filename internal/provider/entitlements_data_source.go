@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+var (
+	_ datasource.DataSource              = &entitlementsDataSource{}
+	_ datasource.DataSourceWithConfigure = &entitlementsDataSource{}
+)
+
+// entitlementTypes maps Discord's numeric entitlement types to the names exposed by the provider.
+var entitlementTypes = map[discord.EntitlementType]string{
+	discord.EntitlementTypePurchase:                "purchase",
+	discord.EntitlementTypePremiumSubscription:     "premium_subscription",
+	discord.EntitlementTypeDeveloperGift:           "developer_gift",
+	discord.EntitlementTypeTestModePurchase:        "test_mode_purchase",
+	discord.EntitlementTypeFreePurchase:            "free_purchase",
+	discord.EntitlementTypeUserGift:                "user_gift",
+	discord.EntitlementTypePremiumPurchase:         "premium_purchase",
+	discord.EntitlementTypeApplicationSubscription: "application_subscription",
+}
+
+func NewEntitlementsDataSource() datasource.DataSource {
+	return &entitlementsDataSource{}
+}
+
+type entitlementsDataSourceModel struct {
+	pageModel
+	ApplicationID SnowflakeValue         `tfsdk:"application_id"`
+	UserID        SnowflakeValue         `tfsdk:"user_id"`
+	SKUIDs        types.Set              `tfsdk:"sku_ids"`
+	GuildID       SnowflakeValue         `tfsdk:"guild_id"`
+	ExcludeEnded  types.Bool             `tfsdk:"exclude_ended"`
+	Entitlements  []entitlementItemModel `tfsdk:"entitlements"`
+}
+
+type entitlementItemModel struct {
+	ID       SnowflakeValue `tfsdk:"id"`
+	SKUID    SnowflakeValue `tfsdk:"sku_id"`
+	UserID   SnowflakeValue `tfsdk:"user_id"`
+	GuildID  SnowflakeValue `tfsdk:"guild_id"`
+	Type     types.String   `tfsdk:"type"`
+	Deleted  types.Bool     `tfsdk:"deleted"`
+	Consumed types.Bool     `tfsdk:"consumed"`
+	StartsAt types.String   `tfsdk:"starts_at"`
+	EndsAt   types.String   `tfsdk:"ends_at"`
+}
+
+// entitlementTypeName returns the provider name of an entitlement type, falling back to its number for types added
+// to discord after the provider was released.
+func entitlementTypeName(entitlementType discord.EntitlementType) string {
+	if name, ok := entitlementTypes[entitlementType]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown_%d", entitlementType)
+}
+
+func (e *entitlementsDataSourceModel) toParams(ctx context.Context) (discord.ListEntitlementsParams, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	page, maxResults := e.toPage()
+	params := discord.ListEntitlementsParams{
+		Page:         page,
+		UserID:       e.UserID.ValueString(),
+		GuildID:      e.GuildID.ValueString(),
+		ExcludeEnded: e.ExcludeEnded.ValueBool(),
+	}
+
+	if !e.SKUIDs.IsNull() && !e.SKUIDs.IsUnknown() {
+		var skuIDs []SnowflakeValue
+		diags.Append(e.SKUIDs.ElementsAs(ctx, &skuIDs, false)...)
+		for _, skuID := range skuIDs {
+			params.SKUIDs = append(params.SKUIDs, skuID.ValueString())
+		}
+	}
+
+	return params, maxResults, diags
+}
+
+func (e *entitlementsDataSourceModel) fromEntitlements(entitlements []discord.Entitlement) {
+	e.Entitlements = []entitlementItemModel{}
+	for _, entitlement := range entitlements {
+		e.Entitlements = append(e.Entitlements, entitlementItemModel{
+			ID:       NewSnowflakeValue(entitlement.ID),
+			SKUID:    NewSnowflakeValue(entitlement.SKUID),
+			UserID:   NewSnowflakePointerValue(entitlement.UserID),
+			GuildID:  NewSnowflakePointerValue(entitlement.GuildID),
+			Type:     types.StringValue(entitlementTypeName(entitlement.Type)),
+			Deleted:  types.BoolValue(entitlement.Deleted),
+			Consumed: types.BoolPointerValue(entitlement.Consumed),
+			StartsAt: types.StringPointerValue(entitlement.StartsAt),
+			EndsAt:   types.StringPointerValue(entitlement.EndsAt),
+		})
+	}
+}
+
+type entitlementsDataSource struct {
+	client *discord.Client
+}
+
+func (e *entitlementsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_entitlements"
+}
+
+func (e *entitlementsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The entitlements granted by a Discord application, optionally filtered by user, guild or SKU. " +
+			"Pages are followed until `max_results` entitlements are collected",
+		Attributes: pageAttributes("entitlements", map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that granted the entitlements",
+				Required:    true,
+			},
+			"user_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "Only return entitlements of this user",
+				Optional:    true,
+			},
+			"sku_ids": schema.SetAttribute{
+				Description: "Only return entitlements to these SKUs",
+				ElementType: SnowflakeType{},
+				Optional:    true,
+			},
+			"guild_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "Only return entitlements of this guild",
+				Optional:    true,
+			},
+			"exclude_ended": schema.BoolAttribute{
+				Description: "Whether to leave out entitlements which have ended",
+				Optional:    true,
+			},
+			"entitlements": schema.ListNestedAttribute{
+				Description: "The entitlements matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the entitlement",
+							Computed:    true,
+						},
+						"sku_id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the SKU the entitlement grants",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the user granted the entitlement",
+							Computed:    true,
+						},
+						"guild_id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the guild granted the entitlement",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the entitlement, such as `purchase`, `test_mode_purchase` or `application_subscription`",
+							Computed:            true,
+						},
+						"deleted": schema.BoolAttribute{
+							Description: "Whether the entitlement was deleted",
+							Computed:    true,
+						},
+						"consumed": schema.BoolAttribute{
+							Description: "Whether a consumable entitlement was consumed",
+							Computed:    true,
+						},
+						"starts_at": schema.StringAttribute{
+							Description: "The time the entitlement starts, unset for test entitlements",
+							Computed:    true,
+						},
+						"ends_at": schema.StringAttribute{
+							Description: "The time the entitlement ends, unset for test entitlements",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (e *entitlementsDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = providerData.api
+}
+
+func (e *entitlementsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state entitlementsDataSourceModel
+	diags := request.Config.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	params, maxResults, diags := state.toParams(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	entitlements, apiResponse, err := e.client.ListAllEntitlements(ctx, state.ApplicationID.ValueString(), params, maxResults)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Entitlements",
+			"Could not read Discord Application Entitlements | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Entitlements",
+			"Could not read Discord Application Entitlements | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	state.fromEntitlements(entitlements)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
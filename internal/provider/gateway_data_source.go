@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+var (
+	_ datasource.DataSource              = &gatewayDataSource{}
+	_ datasource.DataSourceWithConfigure = &gatewayDataSource{}
+)
+
+func NewGatewayDataSource() datasource.DataSource {
+	return &gatewayDataSource{}
+}
+
+type gatewayDataSourceModel struct {
+	URL               types.String           `tfsdk:"url"`
+	Shards            types.Int64            `tfsdk:"shards"`
+	SessionStartLimit sessionStartLimitModel `tfsdk:"session_start_limit"`
+}
+
+type sessionStartLimitModel struct {
+	Total          types.Int64 `tfsdk:"total"`
+	Remaining      types.Int64 `tfsdk:"remaining"`
+	ResetAfter     types.Int64 `tfsdk:"reset_after"`
+	MaxConcurrency types.Int64 `tfsdk:"max_concurrency"`
+}
+
+func (g *gatewayDataSourceModel) fromGatewayBot(gateway *discord.GatewayBot) {
+	g.URL = types.StringValue(gateway.URL)
+	g.Shards = types.Int64Value(int64(gateway.Shards))
+	g.SessionStartLimit = sessionStartLimitModel{
+		Total:          types.Int64Value(int64(gateway.SessionStartLimit.Total)),
+		Remaining:      types.Int64Value(int64(gateway.SessionStartLimit.Remaining)),
+		ResetAfter:     types.Int64Value(int64(gateway.SessionStartLimit.ResetAfter)),
+		MaxConcurrency: types.Int64Value(int64(gateway.SessionStartLimit.MaxConcurrency)),
+	}
+}
+
+type gatewayDataSource struct {
+	client *discord.Client
+}
+
+func (g *gatewayDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_gateway"
+}
+
+func (g *gatewayDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The gateway connection details of the bot the provider token belongs to, including the recommended shard count",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "The WSS URL of the gateway",
+				Computed:    true,
+			},
+			"shards": schema.Int64Attribute{
+				Description: "The recommended number of shards to connect with",
+				Computed:    true,
+			},
+			"session_start_limit": schema.SingleNestedAttribute{
+				Description: "The limits on starting new gateway sessions",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"total": schema.Int64Attribute{
+						Description: "The total number of session starts allowed per reset period",
+						Computed:    true,
+					},
+					"remaining": schema.Int64Attribute{
+						Description: "The number of session starts remaining in the current period",
+						Computed:    true,
+					},
+					"reset_after": schema.Int64Attribute{
+						Description: "The number of milliseconds until the limit resets",
+						Computed:    true,
+					},
+					"max_concurrency": schema.Int64Attribute{
+						Description: "The number of sessions which may start every 5 seconds",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (g *gatewayDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	g.client = providerData.api
+}
+
+func (g *gatewayDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state gatewayDataSourceModel
+
+	gateway, apiResponse, err := g.client.GetGatewayBot(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Gateway",
+			"Could not read Discord Gateway | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Gateway",
+			"Could not read Discord Gateway: "+apiResponse.Status,
+		)
+		return
+	}
+
+	state.fromGatewayBot(gateway)
+
+	diags := response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
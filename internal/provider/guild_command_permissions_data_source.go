@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+var (
+	_ datasource.DataSource              = &guildCommandPermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &guildCommandPermissionsDataSource{}
+)
+
+func NewGuildCommandPermissionsDataSource() datasource.DataSource {
+	return &guildCommandPermissionsDataSource{}
+}
+
+type guildCommandPermissionsDataSourceModel struct {
+	ApplicationID SnowflakeValue                        `tfsdk:"application_id"`
+	GuildID       SnowflakeValue                        `tfsdk:"guild_id"`
+	CommandID     SnowflakeValue                        `tfsdk:"command_id"`
+	Commands      []guildCommandPermissionsCommandModel `tfsdk:"commands"`
+}
+
+type guildCommandPermissionsCommandModel struct {
+	CommandID           SnowflakeValue                    `tfsdk:"command_id"`
+	ApplicationDefaults types.Bool                        `tfsdk:"application_defaults"`
+	Permissions         []guildCommandPermissionItemModel `tfsdk:"permissions"`
+}
+
+type guildCommandPermissionItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	Permission types.Bool   `tfsdk:"permission"`
+	Sentinel   types.String `tfsdk:"sentinel"`
+}
+
+func (g *guildCommandPermissionsDataSourceModel) fromPermissions(permissions []discord.GuildApplicationCommandPermissions) {
+	g.Commands = []guildCommandPermissionsCommandModel{}
+	for _, command := range permissions {
+		if !g.CommandID.IsNull() && command.ID != g.CommandID.ValueString() {
+			continue
+		}
+
+		commandModel := guildCommandPermissionsCommandModel{
+			CommandID:           NewSnowflakeValue(command.ID),
+			ApplicationDefaults: types.BoolValue(command.ID == command.ApplicationID),
+			Permissions:         []guildCommandPermissionItemModel{},
+		}
+
+		for _, permission := range command.Permissions {
+			sentinel := types.StringNull()
+			if name := discord.SentinelName(g.GuildID.ValueString(), permission); name != "" {
+				sentinel = types.StringValue(name)
+			}
+
+			commandModel.Permissions = append(commandModel.Permissions, guildCommandPermissionItemModel{
+				ID:         types.StringValue(permission.ID),
+				Type:       types.StringValue(permissionTypeName(permission.Type)),
+				Permission: types.BoolValue(permission.Permission),
+				Sentinel:   sentinel,
+			})
+		}
+
+		g.Commands = append(g.Commands, commandModel)
+	}
+}
+
+type guildCommandPermissionsDataSource struct {
+	client *discord.Client
+}
+
+func (g *guildCommandPermissionsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_guild_command_permissions"
+}
+
+func (g *guildCommandPermissionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Permission overwrites of every Discord application command within a guild",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that the commands belong to",
+				Required:    true,
+			},
+			"guild_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the guild to read permissions from",
+				Required:    true,
+			},
+			"command_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "Only return the overwrites of the command with this ID",
+				Optional:    true,
+			},
+			"commands": schema.ListNestedAttribute{
+				Description: "The commands with permission overwrites in the guild",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"command_id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the command",
+							Computed:    true,
+						},
+						"application_defaults": schema.BoolAttribute{
+							Description: "Whether these are the application-wide defaults rather than the overwrites of a single command",
+							Computed:    true,
+						},
+						"permissions": schema.ListNestedAttribute{
+							Description: "The permission overwrites of the command",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The ID of the role, user or channel",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of the target - one of `role`, `user` or `channel`",
+										Computed:            true,
+									},
+									"permission": schema.BoolAttribute{
+										Description: "Whether the target is allowed (true) or denied (false) use of the command",
+										Computed:    true,
+									},
+									"sentinel": schema.StringAttribute{
+										MarkdownDescription: fmt.Sprintf("`%s` or `%s` when the ID is one of Discord's special guild-wide IDs, otherwise null",
+											discord.PermissionEveryone, discord.PermissionAllChannels),
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (g *guildCommandPermissionsDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	g.client = providerData.api
+}
+
+func (g *guildCommandPermissionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state guildCommandPermissionsDataSourceModel
+	diags := request.Config.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, apiResponse, err := g.client.GetGuildCommandPermissions(ctx, state.ApplicationID.ValueString(), state.GuildID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Guild Command Permissions",
+			"Could not read Discord Guild Command Permissions | Guild ID: "+state.GuildID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Guild Command Permissions",
+			"Could not read Discord Guild Command Permissions | Guild ID: "+state.GuildID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	if permissions == nil {
+		permissions = &[]discord.GuildApplicationCommandPermissions{}
+	}
+	state.fromPermissions(*permissions)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultRequestTimeout bounds each API request when request_timeout is not configured.
+const defaultRequestTimeout = 60 * time.Second
+
+// httpClientConfig holds the provider settings used to build the http.Client of the API client.
+type httpClientConfig struct {
+	ProxyURL           string
+	CACertPEM          []byte
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+}
+
+// newHTTPClient builds an http.Client from config. Without a proxy URL the proxy environment variables are used, and
+// a CA bundle is trusted in addition to the system certificates.
+func newHTTPClient(config httpClientConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: expected a scheme and host such as http://proxy.example.com:3128", proxyURL.Redacted())
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if len(config.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.CACertPEM) {
+			return nil, fmt.Errorf("no PEM encoded certificates were found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTLSTestServer(t *testing.T) (*httptest.Server, []byte) {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	// Clients which reject the certificate abort the handshake, which the server would otherwise log
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, caPEM
+}
+
+func TestNewHTTPClientTrustsCACertPEM(t *testing.T) {
+	server, caPEM := newTLSTestServer(t)
+
+	client, err := newHTTPClient(httpClientConfig{CACertPEM: caPEM, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error building client: %s", err)
+	}
+
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the custom CA to be trusted, got: %s", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, response.StatusCode)
+	}
+}
+
+func TestNewHTTPClientRejectsUnknownCA(t *testing.T) {
+	server, _ := newTLSTestServer(t)
+
+	client, err := newHTTPClient(httpClientConfig{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error building client: %s", err)
+	}
+
+	response, err := client.Get(server.URL)
+	if err == nil {
+		response.Body.Close()
+		t.Fatal("expected a certificate verification error without the custom CA")
+	}
+}
+
+func TestNewHTTPClientInsecureSkipVerify(t *testing.T) {
+	server, _ := newTLSTestServer(t)
+
+	client, err := newHTTPClient(httpClientConfig{InsecureSkipVerify: true, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error building client: %s", err)
+	}
+
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected certificate verification to be skipped, got: %s", err)
+	}
+	response.Body.Close()
+}
+
+func TestNewHTTPClientRejectsInvalidCACertPEM(t *testing.T) {
+	if _, err := newHTTPClient(httpClientConfig{CACertPEM: []byte("not a certificate")}); err == nil {
+		t.Fatal("expected an error for a CA bundle without certificates")
+	}
+}
+
+func TestNewHTTPClientProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	client, err := newHTTPClient(httpClientConfig{ProxyURL: proxy.URL, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error building client: %s", err)
+	}
+
+	response, err := client.Get("http://discord.invalid/api/v10/gateway")
+	if err != nil {
+		t.Fatalf("expected the request to be routed through the proxy, got: %s", err)
+	}
+	response.Body.Close()
+
+	if proxied != "http://discord.invalid/api/v10/gateway" {
+		t.Errorf("expected the proxy to receive the request, got %q", proxied)
+	}
+}
+
+func TestNewHTTPClientRejectsInvalidProxyURL(t *testing.T) {
+	if _, err := newHTTPClient(httpClientConfig{ProxyURL: "proxy.example.com"}); err == nil {
+		t.Fatal("expected an error for a proxy URL without a scheme")
+	}
+}
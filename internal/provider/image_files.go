@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Image files are profile images, such as an application icon or a bot avatar, uploaded from a local file. Each image
+// has a <prefix>_file attribute holding the path, a <prefix>_file_hash attribute holding the hash of the content last
+// uploaded and a <prefix>_hash attribute holding the hash discord assigned to the image.
+
+// maxImageFileSize is the largest profile image Discord accepts.
+const maxImageFileSize = 10 * 1024 * 1024
+
+var imageFileMediaTypes = []string{mediaTypePNG, mediaTypeJPEG, mediaTypeGIF}
+
+func imageFileAttribute(image string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The path to the %s file. PNG, JPEG and GIF images up to %d MiB are accepted. "+
+			"The image is uploaded again whenever the content of the file changes. When omitted the %s is left unmanaged",
+			image, maxImageFileSize/1024/1024, image),
+		Optional: true,
+	}
+}
+
+func imageFileHashAttribute(image string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The SHA-256 hash of the content of the %s file last uploaded", image),
+		Computed:    true,
+	}
+}
+
+func imageHashAttribute(image string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The hash discord assigned to the current %s", image),
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// planImageFiles hashes the content of the image files with the given prefixes so that changing a file, not only its
+// path, plans an upload.
+func planImageFiles(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, prefixes []string) {
+	for _, prefix := range prefixes {
+		filePath := path.Root(prefix + "_file")
+		fileHashPath := path.Root(prefix + "_file_hash")
+		hashPath := path.Root(prefix + "_hash")
+
+		var file types.String
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, filePath, &file)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if file.IsNull() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, fileHashPath, types.StringNull())...)
+			continue
+		}
+
+		if file.IsUnknown() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, fileHashPath, types.StringUnknown())...)
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, hashPath, types.StringUnknown())...)
+			continue
+		}
+
+		loaded, err := loadImageFile(file.ValueString(), imageFileMediaTypes, maxImageFileSize)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(filePath, "Invalid Image File", err.Error())
+			continue
+		}
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, fileHashPath, types.StringValue(loaded.Hash()))...)
+
+		var stateHash types.String
+		if !request.State.Raw.IsNull() {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, fileHashPath, &stateHash)...)
+		}
+		if stateHash.ValueString() != loaded.Hash() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, hashPath, types.StringUnknown())...)
+		}
+	}
+}
+
+// imageFileUpload loads an image file and records its hash, returning the data URI to upload or nil when the file is
+// not configured or its content matches the prior hash.
+func imageFileUpload(file types.String, fileHash *types.String, priorHash types.String, attributePath path.Path, diags *diag.Diagnostics) *string {
+	if file.IsNull() || file.IsUnknown() {
+		return nil
+	}
+
+	image, err := loadImageFile(file.ValueString(), imageFileMediaTypes, maxImageFileSize)
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid Image File", err.Error())
+		return nil
+	}
+
+	*fileHash = types.StringValue(image.Hash())
+	if priorHash.ValueString() == image.Hash() {
+		return nil
+	}
+
+	dataURI := image.DataURI()
+	return &dataURI
+}
+
+// imageFileDrifted reports whether discord holds a different image than the one last uploaded, in which case the
+// local file hash is cleared so the next plan uploads the file again.
+func imageFileDrifted(priorHash types.String, remoteHash *string) bool {
+	return priorHash.ValueString() != types.StringPointerValue(remoteHash).ValueString()
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	mediaTypePNG  = "image/png"
+	mediaTypeJPEG = "image/jpeg"
+	mediaTypeGIF  = "image/gif"
+	mediaTypeWebP = "image/webp"
+)
+
+// imageSource is an image loaded from a local file path, base64 content or a data URI.
+type imageSource struct {
+	Content   []byte
+	MediaType string
+}
+
+// loadImage reads an image from source, which is tried as a data URI, then a file path and finally as base64 content.
+// The image type is detected from the content rather than trusted from a file extension.
+func loadImage(source string, allowedMediaTypes []string, maxSize int) (*imageSource, error) {
+	var content []byte
+
+	if strings.HasPrefix(source, "data:") {
+		_, encoded, found := strings.Cut(source, ";base64,")
+		if !found {
+			return nil, fmt.Errorf("data URIs must be base64 encoded")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode data URI: %w", err)
+		}
+		content = decoded
+	} else if info, err := os.Stat(source); err == nil && info.Mode().IsRegular() {
+		return loadImageFile(source, allowedMediaTypes, maxSize)
+	} else {
+		decoded, decodeErr := base64.StdEncoding.DecodeString(strings.TrimSpace(source))
+		if decodeErr != nil {
+			return nil, fmt.Errorf("source is neither a readable file nor base64 encoded content")
+		}
+		content = decoded
+	}
+
+	return newImageSource(content, allowedMediaTypes, maxSize)
+}
+
+// loadImageFile reads an image from a local file path only, so a missing or mistyped path reports why the file could
+// not be read.
+func loadImageFile(filePath string, allowedMediaTypes []string, maxSize int) (*imageSource, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read image file: %w", err)
+	}
+
+	return newImageSource(content, allowedMediaTypes, maxSize)
+}
+
+// newImageSource checks the size and detected type of image content.
+func newImageSource(content []byte, allowedMediaTypes []string, maxSize int) (*imageSource, error) {
+	if len(content) == 0 {
+		return nil, fmt.Errorf("the image is empty")
+	}
+
+	if maxSize > 0 && len(content) > maxSize {
+		return nil, fmt.Errorf("the image is %d bytes, larger than the limit of %d bytes", len(content), maxSize)
+	}
+
+	mediaType := http.DetectContentType(content)
+	for _, allowed := range allowedMediaTypes {
+		if mediaType == allowed {
+			return &imageSource{Content: content, MediaType: mediaType}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the image is of type %s, expected one of %s", mediaType, strings.Join(allowedMediaTypes, ", "))
+}
+
+// DataURI encodes the image in the data URI form Discord accepts for uploads.
+func (i *imageSource) DataURI() string {
+	return fmt.Sprintf("data:%s;base64,%s", i.MediaType, base64.StdEncoding.EncodeToString(i.Content))
+}
+
+// Hash returns the hex encoded SHA-256 of the image content, used to detect changes to the image behind a source.
+func (i *imageSource) Hash() string {
+	sum := sha256.Sum256(i.Content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	_ function.Function = &CommandMentionFunction{}
+	_ function.Function = &OAuth2InstallURLFunction{}
+)
+
+const oauth2AuthorizeURL = "https://discord.com/oauth2/authorize"
+
+// commandNamePattern matches a single chat input command, sub-command group or sub-command name.
+var commandNamePattern = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+func NewCommandMentionFunction() function.Function {
+	return &CommandMentionFunction{}
+}
+
+// CommandMentionFunction builds the message markup which renders as a clickable command mention.
+type CommandMentionFunction struct{}
+
+func (f *CommandMentionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "command_mention"
+}
+
+func (f *CommandMentionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a clickable command mention",
+		Description: "Builds the `</name subcommand:id>` markup which Discord renders as a clickable mention of a chat input command. " +
+			"Pass the `name` and `command_id` of a `discord-application_command` resource so the mention never goes stale",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name of the command",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "ID of the command",
+				CustomType:  SnowflakeType{},
+			},
+			function.StringParameter{
+				Name:           "subcommand",
+				Description:    "Sub-command to mention, prefixed by its group when nested in one, such as `user add`. Null or empty mentions the command itself",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CommandMentionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var id SnowflakeValue
+	var subcommand types.String
+
+	resp.Error = req.Arguments.Get(ctx, &name, &id, &subcommand)
+	if resp.Error != nil {
+		return
+	}
+
+	if !commandNamePattern.MatchString(name) || strings.ToLower(name) != name {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid command name, expected 1-32 lowercase letters, numbers, - or _", name))
+		return
+	}
+
+	parts := []string{name}
+	if subcommand.ValueString() != "" {
+		subcommandParts := strings.Fields(subcommand.ValueString())
+		if len(subcommandParts) > 2 {
+			resp.Error = function.NewArgumentFuncError(2, "subcommand may contain at most a sub-command group and a sub-command")
+			return
+		}
+		for _, part := range subcommandParts {
+			if !commandNamePattern.MatchString(part) || strings.ToLower(part) != part {
+				resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("%q is not a valid sub-command name, expected 1-32 lowercase letters, numbers, - or _", part))
+				return
+			}
+		}
+		parts = append(parts, subcommandParts...)
+	}
+
+	resp.Error = resp.Result.Set(ctx, fmt.Sprintf("</%s:%s>", strings.Join(parts, " "), id.ValueString()))
+}
+
+func NewOAuth2InstallURLFunction() function.Function {
+	return &OAuth2InstallURLFunction{}
+}
+
+// OAuth2InstallURLFunction builds the OAuth2 authorization URL used to install an application.
+type OAuth2InstallURLFunction struct{}
+
+func (f *OAuth2InstallURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "oauth2_install_url"
+}
+
+func (f *OAuth2InstallURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds an application install URL",
+		Description: "Builds the Discord OAuth2 authorization URL which installs an application, with every query parameter escaped",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "client_id",
+				Description: "ID of the application to install",
+				CustomType:  SnowflakeType{},
+			},
+			function.ListParameter{
+				Name:        "scopes",
+				Description: "OAuth2 scopes to request, such as `bot` and `applications.commands`",
+				ElementType: types.StringType,
+			},
+			function.StringParameter{
+				Name:           "permissions",
+				Description:    "Decimal permission bitfield requested for the bot, such as the result of the `permissions` function. Null omits the parameter",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "guild_id",
+				Description:    "ID of the guild to preselect. Null lets the user choose",
+				CustomType:     SnowflakeType{},
+				AllowNullValue: true,
+			},
+			function.Int64Parameter{
+				Name:           "integration_type",
+				Description:    "Installation context - 0 to install to a guild, 1 to install to a user. Null omits the parameter",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *OAuth2InstallURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clientID SnowflakeValue
+	var scopes []string
+	var permissions types.String
+	var guildID SnowflakeValue
+	var integrationType types.Int64
+
+	resp.Error = req.Arguments.Get(ctx, &clientID, &scopes, &permissions, &guildID, &integrationType)
+	if resp.Error != nil {
+		return
+	}
+
+	if len(scopes) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "at least one scope must be requested")
+		return
+	}
+	for _, scope := range scopes {
+		if scope == "" || strings.ContainsAny(scope, " \t\n") {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid OAuth2 scope", scope))
+			return
+		}
+	}
+
+	query := url.Values{}
+	query.Set("client_id", clientID.ValueString())
+	query.Set("scope", strings.Join(scopes, " "))
+
+	if !permissions.IsNull() {
+		bitfield, ok := new(big.Int).SetString(permissions.ValueString(), 10)
+		if !ok || bitfield.Sign() < 0 {
+			resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("%q is not a permission bitfield, expected a non-negative decimal integer", permissions.ValueString()))
+			return
+		}
+		query.Set("permissions", bitfield.String())
+	}
+
+	if !guildID.IsNull() {
+		query.Set("guild_id", guildID.ValueString())
+	}
+
+	if !integrationType.IsNull() {
+		if integrationType.ValueInt64() != 0 && integrationType.ValueInt64() != 1 {
+			resp.Error = function.NewArgumentFuncError(4, "integration_type must be 0 (guild install) or 1 (user install)")
+			return
+		}
+		query.Set("integration_type", fmt.Sprint(integrationType.ValueInt64()))
+	}
+
+	resp.Error = resp.Result.Set(ctx, oauth2AuthorizeURL+"?"+query.Encode())
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCommandMentionFunction(t *testing.T) {
+	tests := []struct {
+		name       string
+		command    string
+		subcommand types.String
+		want       string
+		wantError  bool
+	}{
+		{name: "command", command: "ban", subcommand: types.StringNull(), want: "</ban:175928847299117063>"},
+		{name: "empty subcommand", command: "ban", subcommand: types.StringValue(""), want: "</ban:175928847299117063>"},
+		{name: "subcommand", command: "user", subcommand: types.StringValue("add"), want: "</user add:175928847299117063>"},
+		{name: "grouped subcommand", command: "config", subcommand: types.StringValue("roles  add"), want: "</config roles add:175928847299117063>"},
+		{name: "too deep", command: "config", subcommand: types.StringValue("roles add member"), wantError: true},
+		{name: "uppercase subcommand", command: "user", subcommand: types.StringValue("Add"), wantError: true},
+		{name: "uppercase command", command: "Ban", subcommand: types.StringNull(), wantError: true},
+		{name: "command with space", command: "ban user", subcommand: types.StringNull(), wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := runFunction(t, NewCommandMentionFunction(), types.StringUnknown(),
+				types.StringValue(test.command), NewSnowflakeValue("175928847299117063"), test.subcommand)
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if !test.wantError && !got.Equal(types.StringValue(test.want)) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestOAuth2InstallURLFunction(t *testing.T) {
+	tests := []struct {
+		name            string
+		scopes          types.List
+		permissions     types.String
+		guildID         SnowflakeValue
+		integrationType types.Int64
+		want            url.Values
+		wantError       bool
+	}{
+		{
+			name:            "scopes are space separated and escaped",
+			scopes:          stringList("bot", "applications.commands"),
+			permissions:     types.StringNull(),
+			guildID:         NewSnowflakeNull(),
+			integrationType: types.Int64Null(),
+			want:            url.Values{"client_id": {"175928847299117063"}, "scope": {"bot applications.commands"}},
+		},
+		{
+			name:            "every parameter",
+			scopes:          stringList("bot"),
+			permissions:     types.StringValue("0009007199254740993"),
+			guildID:         NewSnowflakeValue("41771983423143937"),
+			integrationType: types.Int64Value(0),
+			want: url.Values{
+				"client_id":        {"175928847299117063"},
+				"scope":            {"bot"},
+				"permissions":      {"9007199254740993"},
+				"guild_id":         {"41771983423143937"},
+				"integration_type": {"0"},
+			},
+		},
+		{
+			name:            "scope with reserved characters",
+			scopes:          stringList("identify&redirect_uri=https://example.com/?a=b#c"),
+			permissions:     types.StringNull(),
+			guildID:         NewSnowflakeNull(),
+			integrationType: types.Int64Null(),
+			want:            url.Values{"client_id": {"175928847299117063"}, "scope": {"identify&redirect_uri=https://example.com/?a=b#c"}},
+		},
+		{name: "no scopes", scopes: stringList(), permissions: types.StringNull(), guildID: NewSnowflakeNull(), integrationType: types.Int64Null(), wantError: true},
+		{name: "scope with space", scopes: stringList("bot applications.commands"), permissions: types.StringNull(), guildID: NewSnowflakeNull(), integrationType: types.Int64Null(), wantError: true},
+		{name: "negative permissions", scopes: stringList("bot"), permissions: types.StringValue("-8"), guildID: NewSnowflakeNull(), integrationType: types.Int64Null(), wantError: true},
+		{name: "permission names", scopes: stringList("bot"), permissions: types.StringValue("ADMINISTRATOR"), guildID: NewSnowflakeNull(), integrationType: types.Int64Null(), wantError: true},
+		{name: "invalid integration type", scopes: stringList("bot"), permissions: types.StringNull(), guildID: NewSnowflakeNull(), integrationType: types.Int64Value(2), wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := runFunction(t, NewOAuth2InstallURLFunction(), types.StringUnknown(),
+				[]attr.Value{NewSnowflakeValue("175928847299117063"), test.scopes, test.permissions, test.guildID, test.integrationType}...)
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if test.wantError {
+				return
+			}
+
+			installURL, parseErr := url.Parse(got.(types.String).ValueString())
+			if parseErr != nil {
+				t.Fatalf("could not parse %s: %s", got, parseErr)
+			}
+			if installURL.Scheme+"://"+installURL.Host+installURL.Path != oauth2AuthorizeURL || installURL.Fragment != "" {
+				t.Errorf("expected a URL of %s without a fragment, got %s", oauth2AuthorizeURL, installURL)
+			}
+			if installURL.Query().Encode() != test.want.Encode() {
+				t.Errorf("expected query %s, got %s", test.want.Encode(), installURL.RawQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultMaxResults caps the items collected by list data sources when max_results is not configured.
+const defaultMaxResults = 1000
+
+// pageModel holds the pagination attributes shared by every list data source.
+type pageModel struct {
+	Before     SnowflakeValue `tfsdk:"before"`
+	After      SnowflakeValue `tfsdk:"after"`
+	Limit      types.Int64    `tfsdk:"limit"`
+	MaxResults types.Int64    `tfsdk:"max_results"`
+}
+
+// toPage converts the pagination attributes to the starting cursor and result cap of discord.ListPages.
+func (p pageModel) toPage() (discord.Page, int) {
+	maxResults := defaultMaxResults
+	if !p.MaxResults.IsNull() && !p.MaxResults.IsUnknown() {
+		maxResults = int(p.MaxResults.ValueInt64())
+	}
+
+	return discord.Page{
+		Before: p.Before.ValueString(),
+		After:  p.After.ValueString(),
+		Limit:  int(p.Limit.ValueInt64()),
+	}, maxResults
+}
+
+// pageAttributes builds the pagination attributes of a list data source, adding them to attributes.
+func pageAttributes(items string, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["before"] = schema.StringAttribute{
+		CustomType:  SnowflakeType{},
+		Description: fmt.Sprintf("Only return %s created before the item with this ID, following pages backward", items),
+		Optional:    true,
+	}
+	attributes["after"] = schema.StringAttribute{
+		CustomType:  SnowflakeType{},
+		Description: fmt.Sprintf("Only return %s created after the item with this ID, following pages forward", items),
+		Optional:    true,
+	}
+	attributes["limit"] = schema.Int64Attribute{
+		Description: fmt.Sprintf("The number of %s requested per page, 1 to %d. Defaults to %d", items, discord.MaxPageLimit, discord.MaxPageLimit),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, discord.MaxPageLimit),
+		},
+	}
+	attributes["max_results"] = schema.Int64Attribute{
+		Description: fmt.Sprintf("The most %s to collect across every page. Defaults to %d", items, defaultMaxResults),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	return attributes
+}
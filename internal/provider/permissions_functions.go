@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &PermissionsFunction{}
+	_ function.Function = &PermissionNamesFunction{}
+)
+
+func NewPermissionsFunction() function.Function {
+	return &PermissionsFunction{}
+}
+
+// PermissionsFunction encodes a list of permission flag names as a decimal bitfield string.
+type PermissionsFunction struct{}
+
+func (f *PermissionsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "permissions"
+}
+
+func (f *PermissionsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encodes Discord permission names as a bitfield",
+		Description: "Combines a list of Discord permission flag names, such as `MANAGE_GUILD` or `BAN_MEMBERS`, into the decimal bitfield string expected by `default_member_permissions` and install parameters. Names are case-insensitive, and `UNKNOWN_BIT_<position>` sets a bit without a known name",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "names",
+				Description: "Permission flag names to combine",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PermissionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var names []string
+
+	resp.Error = req.Arguments.Get(ctx, &names)
+	if resp.Error != nil {
+		return
+	}
+
+	bitfield, err := discord.PermissionBitfield(names)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, bitfield)
+}
+
+func NewPermissionNamesFunction() function.Function {
+	return &PermissionNamesFunction{}
+}
+
+// PermissionNamesFunction decodes a decimal bitfield string into the names of the permission flags it contains.
+type PermissionNamesFunction struct{}
+
+func (f *PermissionNamesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "permission_names"
+}
+
+func (f *PermissionNamesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decodes a Discord permission bitfield into permission names",
+		Description: "Splits a decimal Discord permission bitfield string into the names of the flags it contains, ordered by bit position. Bits which do not correspond to a known permission are named `UNKNOWN_BIT_<position>`, which `permissions` accepts back",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "bitfield",
+				Description: "Decimal permission bitfield, such as `8`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *PermissionNamesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bitfield string
+
+	resp.Error = req.Arguments.Get(ctx, &bitfield)
+	if resp.Error != nil {
+		return
+	}
+
+	names, err := discord.PermissionNames(bitfield)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if names == nil {
+		names = []string{}
+	}
+
+	resp.Error = resp.Result.Set(ctx, names)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls a provider function with args, returning its result or error. result is the unknown value of the
+// function's return type.
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	request := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	response := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), request, &response)
+
+	return response.Result.Value(), response.Error
+}
+
+func stringList(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestPermissionsFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		names     types.List
+		want      string
+		wantError bool
+	}{
+		{name: "none", names: stringList(), want: "0"},
+		{name: "names", names: stringList("BAN_MEMBERS", "kick_members"), want: "6"},
+		{name: "beyond float64 precision", names: stringList("UNKNOWN_BIT_53", "ADMINISTRATOR"), want: "9007199254741000"},
+		{name: "unknown name", names: stringList("MANAGE_EVERYTHING"), wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := runFunction(t, NewPermissionsFunction(), types.StringUnknown(), test.names)
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if !test.wantError && !got.Equal(types.StringValue(test.want)) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPermissionNamesFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		bitfield  string
+		want      types.List
+		wantError bool
+	}{
+		{name: "none", bitfield: "0", want: stringList()},
+		{name: "names", bitfield: "6", want: stringList("KICK_MEMBERS", "BAN_MEMBERS")},
+		{name: "voice channel status", bitfield: "281474976710656", want: stringList("SET_VOICE_CHANNEL_STATUS")},
+		{name: "unknown bits", bitfield: "9007199254741000", want: stringList("ADMINISTRATOR", "UNKNOWN_BIT_53")},
+		{name: "invalid", bitfield: "-1", wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := runFunction(t, NewPermissionNamesFunction(), types.ListUnknown(types.StringType), types.StringValue(test.bitfield))
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if !test.wantError && !got.Equal(test.want) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPermissionFunctionsRoundTrip(t *testing.T) {
+	names, err := runFunction(t, NewPermissionNamesFunction(), types.ListUnknown(types.StringType), types.StringValue("1153343717071912960"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bitfield, err := runFunction(t, NewPermissionsFunction(), types.StringUnknown(), names)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bitfield.Equal(types.StringValue("1153343717071912960")) {
+		t.Errorf("expected the bitfield to survive a round trip, got %s via %s", bitfield, names)
+	}
+}
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+)
+
+var (
+	_ resource.Resource                   = &roleConnectionMetadataResource{}
+	_ resource.ResourceWithConfigure      = &roleConnectionMetadataResource{}
+	_ resource.ResourceWithImportState    = &roleConnectionMetadataResource{}
+	_ resource.ResourceWithValidateConfig = &roleConnectionMetadataResource{}
+)
+
+// roleConnectionMetadataTypes maps the metadata type names used in configuration to Discord's numeric types.
+var roleConnectionMetadataTypes = map[string]discord.ApplicationRoleConnectionMetadataType{
+	"integer_less_than_or_equal":     discord.ApplicationRoleConnectionMetadataTypeIntegerLessThanOrEqual,
+	"integer_greater_than_or_equal":  discord.ApplicationRoleConnectionMetadataTypeIntegerGreaterThanOrEqual,
+	"integer_equal":                  discord.ApplicationRoleConnectionMetadataTypeIntegerEqual,
+	"integer_not_equal":              discord.ApplicationRoleConnectionMetadataTypeIntegerNotEqual,
+	"datetime_less_than_or_equal":    discord.ApplicationRoleConnectionMetadataTypeDatetimeLessThanOrEqual,
+	"datetime_greater_than_or_equal": discord.ApplicationRoleConnectionMetadataTypeDatetimeGreaterThanOrEqual,
+	"boolean_equal":                  discord.ApplicationRoleConnectionMetadataTypeBooleanEqual,
+	"boolean_not_equal":              discord.ApplicationRoleConnectionMetadataTypeBooleanNotEqual,
+}
+
+func roleConnectionMetadataTypeName(metadataType discord.ApplicationRoleConnectionMetadataType) string {
+	for name, t := range roleConnectionMetadataTypes {
+		if t == metadataType {
+			return name
+		}
+	}
+	return fmt.Sprintf("unknown (%d)", metadataType)
+}
+
+var roleConnectionMetadataKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+func NewRoleConnectionMetadataResource() resource.Resource {
+	return &roleConnectionMetadataResource{}
+}
+
+type roleConnectionMetadataResourceModel struct {
+	ApplicationID SnowflakeValue                      `tfsdk:"application_id"`
+	Records       []roleConnectionMetadataRecordModel `tfsdk:"record"`
+	LastUpdated   types.String                        `tfsdk:"last_updated"`
+}
+
+type roleConnectionMetadataRecordModel struct {
+	Key                      types.String `tfsdk:"key"`
+	Type                     types.String `tfsdk:"type"`
+	Name                     types.String `tfsdk:"name"`
+	NameLocalizations        types.Map    `tfsdk:"name_localizations"`
+	Description              types.String `tfsdk:"description"`
+	DescriptionLocalizations types.Map    `tfsdk:"description_localizations"`
+}
+
+func (r *roleConnectionMetadataResourceModel) toRequest() []discord.ApplicationRoleConnectionMetadata {
+	records := []discord.ApplicationRoleConnectionMetadata{}
+	for _, record := range r.Records {
+		records = append(records, discord.ApplicationRoleConnectionMetadata{
+			Type:                     roleConnectionMetadataTypes[record.Type.ValueString()],
+			Key:                      record.Key.ValueString(),
+			Name:                     record.Name.ValueString(),
+			NameLocalizations:        localizationsFromMap(record.NameLocalizations),
+			Description:              record.Description.ValueString(),
+			DescriptionLocalizations: localizationsFromMap(record.DescriptionLocalizations),
+		})
+	}
+	return records
+}
+
+// fromRecords maps the API response onto the model. Discord reports missing localizations as empty maps, which are
+// kept null when the existing record at the same key had none configured.
+func (r *roleConnectionMetadataResourceModel) fromRecords(records []discord.ApplicationRoleConnectionMetadata) {
+	prior := map[string]roleConnectionMetadataRecordModel{}
+	for _, record := range r.Records {
+		prior[record.Key.ValueString()] = record
+	}
+
+	r.Records = []roleConnectionMetadataRecordModel{}
+	for _, record := range records {
+		model := roleConnectionMetadataRecordModel{
+			Key:                      types.StringValue(record.Key),
+			Type:                     types.StringValue(roleConnectionMetadataTypeName(record.Type)),
+			Name:                     types.StringValue(record.Name),
+			NameLocalizations:        localizationsToMap(normalizeLocalizations(record.NameLocalizations)),
+			Description:              types.StringValue(record.Description),
+			DescriptionLocalizations: localizationsToMap(normalizeLocalizations(record.DescriptionLocalizations)),
+		}
+
+		if priorRecord, ok := prior[record.Key]; ok {
+			if model.NameLocalizations.IsNull() && !priorRecord.NameLocalizations.IsNull() && len(priorRecord.NameLocalizations.Elements()) == 0 {
+				model.NameLocalizations = priorRecord.NameLocalizations
+			}
+			if model.DescriptionLocalizations.IsNull() && !priorRecord.DescriptionLocalizations.IsNull() && len(priorRecord.DescriptionLocalizations.Elements()) == 0 {
+				model.DescriptionLocalizations = priorRecord.DescriptionLocalizations
+			}
+		}
+
+		r.Records = append(r.Records, model)
+	}
+	r.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+}
+
+// apply replaces the application's metadata records with those in the model and refreshes the model from the response.
+func (r *roleConnectionMetadataResourceModel) apply(ctx context.Context, client *discord.Client, diags *diag.Diagnostics) {
+	records, apiResponse, err := client.UpdateRoleConnectionMetadata(ctx, r.ApplicationID.ValueString(), r.toRequest())
+	if err != nil {
+		diags.AddError(
+			"Error Updating Discord Role Connection Metadata",
+			"Could not update role connection metadata, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		diags.AddError(
+			"Error Updating Discord Role Connection Metadata",
+			fmt.Sprintf("Could not update role connection metadata of application ID %s, unexpected status code: %d", r.ApplicationID.ValueString(), apiResponse.StatusCode),
+		)
+		return
+	}
+
+	if records == nil {
+		records = &[]discord.ApplicationRoleConnectionMetadata{}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	r.fromRecords(*records)
+}
+
+type roleConnectionMetadataResource struct {
+	client *discord.Client
+}
+
+func (r *roleConnectionMetadataResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "discord-application_role_connection_metadata"
+}
+
+func (r *roleConnectionMetadataResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	typeNames := make([]string, 0, len(roleConnectionMetadataTypes))
+	for name := range roleConnectionMetadataTypes {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The full list of role connection metadata records of a Discord application, used by linked roles. " +
+			"Records missing from the configuration are removed from the application.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that the metadata belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the metadata was updated",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"record": schema.ListNestedBlock{
+				Description: fmt.Sprintf("A role connection metadata record, at most %d per application", discord.MaxRoleConnectionMetadataRecords),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The dictionary key of the metadata field - 1 to 50 characters of `a-z`, `0-9` or `_`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(roleConnectionMetadataKeyPattern, "must be 1 to 50 characters of a-z, 0-9 or _"),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The comparison the metadata value is checked with - one of `integer_less_than_or_equal`, " +
+								"`integer_greater_than_or_equal`, `integer_equal`, `integer_not_equal`, `datetime_less_than_or_equal`, " +
+								"`datetime_greater_than_or_equal`, `boolean_equal` or `boolean_not_equal`",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(typeNames...),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the metadata field, 1 to 100 characters",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"name_localizations": schema.MapAttribute{
+							Description: "Localized names of the metadata field keyed by locale",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the metadata field, 1 to 200 characters",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+						"description_localizations": schema.MapAttribute{
+							Description: "Localized descriptions of the metadata field keyed by locale",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(discord.MaxRoleConnectionMetadataRecords),
+				},
+			},
+		},
+	}
+}
+
+func (r *roleConnectionMetadataResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config roleConnectionMetadataResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keys := map[string]bool{}
+	for i, record := range config.Records {
+		if record.Key.IsUnknown() || record.Key.IsNull() {
+			continue
+		}
+
+		if keys[record.Key.ValueString()] {
+			response.Diagnostics.AddAttributeError(
+				path.Root("record").AtListIndex(i).AtName("key"),
+				"Duplicate metadata key",
+				fmt.Sprintf("The key %q is used by more than one record", record.Key.ValueString()),
+			)
+		}
+		keys[record.Key.ValueString()] = true
+	}
+}
+
+func (r *roleConnectionMetadataResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.api
+}
+
+func (r *roleConnectionMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), req, resp)
+}
+
+func (r *roleConnectionMetadataResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan roleConnectionMetadataResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.apply(ctx, r.client, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *roleConnectionMetadataResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state roleConnectionMetadataResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed records from discord
+	records, apiResponse, err := r.client.GetRoleConnectionMetadata(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Role Connection Metadata",
+			"Could not read Discord Role Connection Metadata | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Role Connection Metadata",
+			"Could not read Discord Role Connection Metadata | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	if records == nil {
+		records = &[]discord.ApplicationRoleConnectionMetadata{}
+	}
+
+	// Overwrite items with refreshed state
+	state.fromRecords(*records)
+
+	// Set refreshed state
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *roleConnectionMetadataResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan roleConnectionMetadataResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.apply(ctx, r.client, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *roleConnectionMetadataResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state roleConnectionMetadataResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove every record from the application
+	_, apiResponse, err := r.client.UpdateRoleConnectionMetadata(ctx, state.ApplicationID.ValueString(), []discord.ApplicationRoleConnectionMetadata{})
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Role Connection Metadata",
+			"Could not delete role connection metadata, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Role Connection Metadata",
+			"Could not delete Discord Role Connection Metadata of application ID "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+var (
+	_ datasource.DataSource              = &skuSubscriptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &skuSubscriptionsDataSource{}
+)
+
+// subscriptionStatuses maps Discord's numeric subscription statuses to the names exposed by the provider.
+var subscriptionStatuses = map[discord.SubscriptionStatus]string{
+	discord.SubscriptionStatusActive:   "active",
+	discord.SubscriptionStatusEnding:   "ending",
+	discord.SubscriptionStatusInactive: "inactive",
+}
+
+func NewSKUSubscriptionsDataSource() datasource.DataSource {
+	return &skuSubscriptionsDataSource{}
+}
+
+type skuSubscriptionsDataSourceModel struct {
+	pageModel
+	SKUID         SnowflakeValue          `tfsdk:"sku_id"`
+	UserID        SnowflakeValue          `tfsdk:"user_id"`
+	Subscriptions []subscriptionItemModel `tfsdk:"subscriptions"`
+}
+
+type subscriptionItemModel struct {
+	ID                 SnowflakeValue   `tfsdk:"id"`
+	UserID             SnowflakeValue   `tfsdk:"user_id"`
+	Status             types.String     `tfsdk:"status"`
+	CurrentPeriodStart types.String     `tfsdk:"current_period_start"`
+	CurrentPeriodEnd   types.String     `tfsdk:"current_period_end"`
+	CanceledAt         types.String     `tfsdk:"canceled_at"`
+	SKUIDs             []SnowflakeValue `tfsdk:"sku_ids"`
+	EntitlementIDs     []SnowflakeValue `tfsdk:"entitlement_ids"`
+	RenewalSKUIDs      []SnowflakeValue `tfsdk:"renewal_sku_ids"`
+}
+
+func snowflakeValues(ids []string) []SnowflakeValue {
+	values := []SnowflakeValue{}
+	for _, id := range ids {
+		values = append(values, NewSnowflakeValue(id))
+	}
+	return values
+}
+
+func (s *skuSubscriptionsDataSourceModel) fromSubscriptions(subscriptions []discord.Subscription) {
+	s.Subscriptions = []subscriptionItemModel{}
+	for _, subscription := range subscriptions {
+		status, ok := subscriptionStatuses[subscription.Status]
+		if !ok {
+			status = fmt.Sprintf("unknown_%d", subscription.Status)
+		}
+
+		// A subscription without renewal SKUs renews to the SKUs it already has
+		var renewalSKUIDs []SnowflakeValue
+		if subscription.RenewalSKUIDs != nil {
+			renewalSKUIDs = snowflakeValues(*subscription.RenewalSKUIDs)
+		}
+
+		s.Subscriptions = append(s.Subscriptions, subscriptionItemModel{
+			ID:                 NewSnowflakeValue(subscription.ID),
+			UserID:             NewSnowflakeValue(subscription.UserID),
+			Status:             types.StringValue(status),
+			CurrentPeriodStart: types.StringValue(subscription.CurrentPeriodStart),
+			CurrentPeriodEnd:   types.StringValue(subscription.CurrentPeriodEnd),
+			CanceledAt:         types.StringPointerValue(subscription.CanceledAt),
+			SKUIDs:             snowflakeValues(subscription.SKUIDs),
+			EntitlementIDs:     snowflakeValues(subscription.EntitlementIDs),
+			RenewalSKUIDs:      renewalSKUIDs,
+		})
+	}
+}
+
+type skuSubscriptionsDataSource struct {
+	client *discord.Client
+}
+
+func (s *skuSubscriptionsDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_sku_subscriptions"
+}
+
+func (s *skuSubscriptionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The subscriptions of a user to a subscription SKU. Pages are followed until `max_results` subscriptions are collected",
+		Attributes: pageAttributes("subscriptions", map[string]schema.Attribute{
+			"sku_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the subscription SKU",
+				Required:    true,
+			},
+			"user_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the user whose subscriptions are returned. Discord requires a user when subscriptions are listed with a bot token",
+				Required:    true,
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Description: "The subscriptions to the SKU",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the subscription",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the subscribed user",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the subscription - `active`, `ending` or `inactive`",
+							Computed:            true,
+						},
+						"current_period_start": schema.StringAttribute{
+							Description: "The start of the current subscription period",
+							Computed:    true,
+						},
+						"current_period_end": schema.StringAttribute{
+							Description: "The end of the current subscription period",
+							Computed:    true,
+						},
+						"canceled_at": schema.StringAttribute{
+							Description: "The time the subscription was canceled, unset while it renews",
+							Computed:    true,
+						},
+						"sku_ids": schema.ListAttribute{
+							Description: "The IDs of the SKUs subscribed to",
+							ElementType: SnowflakeType{},
+							Computed:    true,
+						},
+						"entitlement_ids": schema.ListAttribute{
+							Description: "The IDs of the entitlements granted by the subscription",
+							ElementType: SnowflakeType{},
+							Computed:    true,
+						},
+						"renewal_sku_ids": schema.ListAttribute{
+							Description: "The IDs of the SKUs the subscription renews to, unset when it renews to its current SKUs",
+							ElementType: SnowflakeType{},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (s *skuSubscriptionsDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = providerData.api
+}
+
+func (s *skuSubscriptionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state skuSubscriptionsDataSourceModel
+	diags := request.Config.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	page, maxResults := state.toPage()
+	subscriptions, apiResponse, err := s.client.ListAllSKUSubscriptions(ctx, state.SKUID.ValueString(), discord.ListSKUSubscriptionsParams{
+		Page:   page,
+		UserID: state.UserID.ValueString(),
+	}, maxResults)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord SKU Subscriptions",
+			"Could not read Discord SKU Subscriptions | SKU ID: "+state.SKUID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord SKU Subscriptions",
+			"Could not read Discord SKU Subscriptions | SKU ID: "+state.SKUID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	state.fromSubscriptions(subscriptions)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
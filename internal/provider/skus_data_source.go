@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"sort"
+)
+
+var (
+	_ datasource.DataSource              = &skusDataSource{}
+	_ datasource.DataSourceWithConfigure = &skusDataSource{}
+)
+
+// skuTypes maps Discord's numeric SKU types to the names exposed by the provider.
+var skuTypes = map[discord.SKUType]string{
+	discord.SKUTypeDurable:           "durable",
+	discord.SKUTypeConsumable:        "consumable",
+	discord.SKUTypeSubscription:      "subscription",
+	discord.SKUTypeSubscriptionGroup: "subscription_group",
+}
+
+func NewSKUsDataSource() datasource.DataSource {
+	return &skusDataSource{}
+}
+
+type skusDataSourceModel struct {
+	ApplicationID SnowflakeValue    `tfsdk:"application_id"`
+	SKUs          []skuItemModel    `tfsdk:"skus"`
+	IDs           map[string]string `tfsdk:"ids"`
+}
+
+type skuItemModel struct {
+	ID    SnowflakeValue `tfsdk:"id"`
+	Type  types.String   `tfsdk:"type"`
+	Name  types.String   `tfsdk:"name"`
+	Slug  types.String   `tfsdk:"slug"`
+	Flags types.Set      `tfsdk:"flags"`
+}
+
+func (s *skusDataSourceModel) fromSKUs(ctx context.Context, skus []discord.SKU) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	s.SKUs = []skuItemModel{}
+	s.IDs = map[string]string{}
+	for _, sku := range skus {
+		flags := []string{}
+		for name, flag := range discord.SKUFlags {
+			if sku.Flags&flag != 0 {
+				flags = append(flags, name)
+			}
+		}
+		sort.Strings(flags)
+		flagSet, setDiags := types.SetValueFrom(ctx, types.StringType, flags)
+		diags.Append(setDiags...)
+
+		skuType, ok := skuTypes[sku.Type]
+		if !ok {
+			skuType = fmt.Sprintf("unknown_%d", sku.Type)
+		}
+
+		s.SKUs = append(s.SKUs, skuItemModel{
+			ID:    NewSnowflakeValue(sku.ID),
+			Type:  types.StringValue(skuType),
+			Name:  types.StringValue(sku.Name),
+			Slug:  types.StringValue(sku.Slug),
+			Flags: flagSet,
+		})
+		s.IDs[sku.Slug] = sku.ID
+	}
+
+	return diags
+}
+
+type skusDataSource struct {
+	client *discord.Client
+}
+
+func (s *skusDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "discord-application_skus"
+}
+
+func (s *skusDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Every SKU sold by a Discord application",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that sells the SKUs",
+				Required:    true,
+			},
+			"skus": schema.ListNestedAttribute{
+				Description: "The SKUs of the application",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:  SnowflakeType{},
+							Description: "The ID of the SKU",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the SKU - `durable`, `consumable`, `subscription` or `subscription_group`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The customer facing name of the SKU",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The system generated URL slug of the SKU",
+							Computed:    true,
+						},
+						"flags": schema.SetAttribute{
+							MarkdownDescription: "The flags set on the SKU - `AVAILABLE`, `GUILD_SUBSCRIPTION` and `USER_SUBSCRIPTION`",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "The ID of every SKU keyed by SKU slug",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (s *skusDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = providerData.api
+}
+
+func (s *skusDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state skusDataSourceModel
+	diags := request.Config.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	skus, apiResponse, err := s.client.GetSKUs(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application SKUs",
+			"Could not read Discord Application SKUs | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application SKUs",
+			"Could not read Discord Application SKUs | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	if skus == nil {
+		skus = &[]discord.SKU{}
+	}
+	diags = state.fromSKUs(ctx, *skus)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
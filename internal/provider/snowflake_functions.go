@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+var (
+	_ function.Function = &SnowflakeTimestampFunction{}
+	_ function.Function = &SnowflakePartsFunction{}
+)
+
+func NewSnowflakeTimestampFunction() function.Function {
+	return &SnowflakeTimestampFunction{}
+}
+
+// SnowflakeTimestampFunction returns the creation time encoded in a Discord ID.
+type SnowflakeTimestampFunction struct{}
+
+func (f *SnowflakeTimestampFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "snowflake_timestamp"
+}
+
+func (f *SnowflakeTimestampFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the creation time of a Discord ID",
+		Description: "Decodes the creation time from a Discord snowflake ID and returns it as an RFC 3339 timestamp in UTC, compatible with Terraform's built-in time functions",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Discord snowflake ID, such as an application, command or guild ID",
+				CustomType:  SnowflakeType{},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SnowflakeTimestampFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id SnowflakeValue
+
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	snowflake, err := discord.ParseSnowflake(id.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, snowflake.Timestamp.Format(time.RFC3339Nano))
+}
+
+func NewSnowflakePartsFunction() function.Function {
+	return &SnowflakePartsFunction{}
+}
+
+var snowflakePartsAttrTypes = map[string]attr.Type{
+	"timestamp":    types.StringType,
+	"timestamp_ms": types.Int64Type,
+	"worker_id":    types.Int64Type,
+	"process_id":   types.Int64Type,
+	"increment":    types.Int64Type,
+}
+
+type snowflakePartsModel struct {
+	Timestamp   types.String `tfsdk:"timestamp"`
+	TimestampMs types.Int64  `tfsdk:"timestamp_ms"`
+	WorkerID    types.Int64  `tfsdk:"worker_id"`
+	ProcessID   types.Int64  `tfsdk:"process_id"`
+	Increment   types.Int64  `tfsdk:"increment"`
+}
+
+// SnowflakePartsFunction decodes every component of a Discord ID.
+type SnowflakePartsFunction struct{}
+
+func (f *SnowflakePartsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "snowflake_parts"
+}
+
+func (f *SnowflakePartsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decodes the components of a Discord ID",
+		Description: "Decodes a Discord snowflake ID into an object holding its creation time as an RFC 3339 `timestamp` and as " +
+			"Unix milliseconds in `timestamp_ms`, along with the internal `worker_id`, `process_id` and `increment`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Discord snowflake ID, such as an application, command or guild ID",
+				CustomType:  SnowflakeType{},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: snowflakePartsAttrTypes,
+		},
+	}
+}
+
+func (f *SnowflakePartsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id SnowflakeValue
+
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	snowflake, err := discord.ParseSnowflake(id.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, snowflakePartsModel{
+		Timestamp:   types.StringValue(snowflake.Timestamp.Format(time.RFC3339Nano)),
+		TimestampMs: types.Int64Value(snowflake.Timestamp.UnixMilli()),
+		WorkerID:    types.Int64Value(int64(snowflake.WorkerID)),
+		ProcessID:   types.Int64Value(int64(snowflake.ProcessID)),
+		Increment:   types.Int64Value(int64(snowflake.Increment)),
+	})
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeTimestampFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		want      string
+		wantError bool
+	}{
+		{name: "documented example", id: "175928847299117063", want: "2016-04-30T11:18:25.796Z"},
+		{name: "discord epoch", id: "0", want: "2015-01-01T00:00:00Z"},
+		{name: "invalid", id: "abc", wantError: true},
+		{name: "overflow", id: "18446744073709551616", wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := runFunction(t, NewSnowflakeTimestampFunction(), types.StringUnknown(), NewSnowflakeValue(test.id))
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error %t, got %v", test.wantError, err)
+			}
+			if !test.wantError && !got.Equal(types.StringValue(test.want)) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestSnowflakePartsFunction(t *testing.T) {
+	got, err := runFunction(t, NewSnowflakePartsFunction(), types.ObjectUnknown(snowflakePartsAttrTypes), NewSnowflakeValue("175928847299117063"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := types.ObjectValueMust(snowflakePartsAttrTypes, map[string]attr.Value{
+		"timestamp":    types.StringValue("2016-04-30T11:18:25.796Z"),
+		"timestamp_ms": types.Int64Value(1462015105796),
+		"worker_id":    types.Int64Value(1),
+		"process_id":   types.Int64Value(0),
+		"increment":    types.Int64Value(7),
+	})
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable        = SnowflakeType{}
+	_ basetypes.StringValuable       = SnowflakeValue{}
+	_ xattr.ValidateableAttribute    = SnowflakeValue{}
+	_ function.ValidateableParameter = SnowflakeValue{}
+)
+
+// SnowflakeType is a string attribute type holding a Discord ID, which must be the decimal form of an unsigned 64-bit
+// integer. Typos are reported during validation rather than as a 404 at apply time.
+type SnowflakeType struct {
+	basetypes.StringType
+}
+
+func (t SnowflakeType) Equal(o attr.Type) bool {
+	other, ok := o.(SnowflakeType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SnowflakeType) String() string {
+	return "SnowflakeType"
+}
+
+func (t SnowflakeType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SnowflakeValue{StringValue: in}, nil
+}
+
+func (t SnowflakeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return SnowflakeValue{StringValue: stringValue}, nil
+}
+
+func (t SnowflakeType) ValueType(_ context.Context) attr.Value {
+	return SnowflakeValue{}
+}
+
+// SnowflakeValue is the value of a SnowflakeType attribute.
+type SnowflakeValue struct {
+	basetypes.StringValue
+}
+
+func NewSnowflakeValue(value string) SnowflakeValue {
+	return SnowflakeValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewSnowflakeNull() SnowflakeValue {
+	return SnowflakeValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewSnowflakeUnknown() SnowflakeValue {
+	return SnowflakeValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewSnowflakePointerValue(value *string) SnowflakeValue {
+	return SnowflakeValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+func (v SnowflakeValue) Equal(o attr.Value) bool {
+	other, ok := o.(SnowflakeValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SnowflakeValue) Type(_ context.Context) attr.Type {
+	return SnowflakeType{}
+}
+
+func (v SnowflakeValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := discord.ParseSnowflake(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Discord ID", err.Error())
+	}
+}
+
+func (v SnowflakeValue) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := discord.ParseSnowflake(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(req.Position, err.Error())
+	}
+}
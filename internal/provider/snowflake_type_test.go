@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestSnowflakeValueValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     SnowflakeValue
+		wantError bool
+	}{
+		{name: "valid", value: NewSnowflakeValue("175928847299117063")},
+		{name: "largest", value: NewSnowflakeValue("18446744073709551615")},
+		{name: "null", value: NewSnowflakeNull()},
+		{name: "unknown", value: NewSnowflakeUnknown()},
+		{name: "overflow", value: NewSnowflakeValue("18446744073709551616"), wantError: true},
+		{name: "negative", value: NewSnowflakeValue("-175928847299117063"), wantError: true},
+		{name: "empty", value: NewSnowflakeValue(""), wantError: true},
+		{name: "mention", value: NewSnowflakeValue("<@175928847299117063>"), wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var response xattr.ValidateAttributeResponse
+			test.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("id")}, &response)
+			if response.Diagnostics.HasError() != test.wantError {
+				t.Errorf("expected error %t, got %v", test.wantError, response.Diagnostics)
+			}
+		})
+	}
+}
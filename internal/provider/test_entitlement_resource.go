@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	_ resource.Resource                = &testEntitlementResource{}
+	_ resource.ResourceWithConfigure   = &testEntitlementResource{}
+	_ resource.ResourceWithImportState = &testEntitlementResource{}
+)
+
+// testEntitlementOwnerTypes maps the owner type names used in configuration to Discord's numeric owner types.
+var testEntitlementOwnerTypes = map[string]discord.TestEntitlementOwnerType{
+	"guild": discord.TestEntitlementOwnerTypeGuild,
+	"user":  discord.TestEntitlementOwnerTypeUser,
+}
+
+func NewTestEntitlementResource() resource.Resource {
+	return &testEntitlementResource{}
+}
+
+type testEntitlementResourceModel struct {
+	ApplicationID SnowflakeValue `tfsdk:"application_id"`
+	EntitlementID SnowflakeValue `tfsdk:"entitlement_id"`
+	SKUID         SnowflakeValue `tfsdk:"sku_id"`
+	OwnerType     types.String   `tfsdk:"owner_type"`
+	OwnerID       SnowflakeValue `tfsdk:"owner_id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+}
+
+func (t *testEntitlementResourceModel) fromEntitlement(entitlement *discord.Entitlement) {
+	t.EntitlementID = NewSnowflakeValue(entitlement.ID)
+	t.SKUID = NewSnowflakeValue(entitlement.SKUID)
+	if entitlement.GuildID != nil {
+		t.OwnerType = types.StringValue("guild")
+		t.OwnerID = NewSnowflakeValue(*entitlement.GuildID)
+	} else if entitlement.UserID != nil {
+		t.OwnerType = types.StringValue("user")
+		t.OwnerID = NewSnowflakeValue(*entitlement.UserID)
+	}
+	t.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+}
+
+// matches reports whether an entitlement is an active test entitlement of the model's SKU and owner.
+func (t *testEntitlementResourceModel) matches(entitlement discord.Entitlement) bool {
+	if entitlement.Type != discord.EntitlementTypeTestModePurchase || entitlement.Deleted || entitlementConsumed(entitlement) {
+		return false
+	}
+	if entitlement.SKUID != t.SKUID.ValueString() {
+		return false
+	}
+	ownerID := entitlement.UserID
+	if t.OwnerType.ValueString() == "guild" {
+		ownerID = entitlement.GuildID
+	}
+	return ownerID != nil && *ownerID == t.OwnerID.ValueString()
+}
+
+func entitlementConsumed(entitlement discord.Entitlement) bool {
+	return entitlement.Consumed != nil && *entitlement.Consumed
+}
+
+type testEntitlementResource struct {
+	client *discord.Client
+}
+
+func (t *testEntitlementResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "discord-application_test_entitlement"
+}
+
+func (t *testEntitlementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "A test entitlement granting a guild or user a SKU without payment, for testing premium features. " +
+			"An active test entitlement matching the configuration is adopted rather than duplicated, " +
+			"and an entitlement which is consumed or removed outside of terraform is created again.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The application ID that owns the SKU",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entitlement_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the entitlement",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sku_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the SKU to grant",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the entitlement owner - `guild` or `user`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("guild", "user"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				CustomType:  SnowflakeType{},
+				Description: "The ID of the guild or user granted the entitlement",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the entitlement was updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (t *testEntitlementResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*discordProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *discordProviderData, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	t.client = providerData.api
+}
+
+func (t *testEntitlementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, "-")
+	if len(ids) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: application_id-entitlement_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), resource.ImportStateRequest{ID: ids[0]}, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("entitlement_id"), resource.ImportStateRequest{ID: ids[1]}, resp)
+}
+
+// findExisting looks for an active test entitlement matching the model, so that creating the resource is idempotent.
+func (t *testEntitlementResource) findExisting(ctx context.Context, model *testEntitlementResourceModel) (*discord.Entitlement, error) {
+	params := discord.ListEntitlementsParams{
+		SKUIDs:         []string{model.SKUID.ValueString()},
+		ExcludeEnded:   true,
+		ExcludeDeleted: true,
+	}
+	if model.OwnerType.ValueString() == "guild" {
+		params.GuildID = model.OwnerID.ValueString()
+	} else {
+		params.UserID = model.OwnerID.ValueString()
+	}
+
+	entitlements, apiResponse, err := t.client.ListAllEntitlements(ctx, model.ApplicationID.ValueString(), params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", apiResponse.StatusCode)
+	}
+
+	for _, entitlement := range entitlements {
+		if model.matches(entitlement) {
+			return &entitlement, nil
+		}
+	}
+	return nil, nil
+}
+
+func (t *testEntitlementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan testEntitlementResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	entitlement, err := t.findExisting(ctx, &plan)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error creating test entitlement",
+			"Could not list existing entitlements: "+err.Error(),
+		)
+		return
+	}
+
+	if entitlement == nil {
+		var apiResponse *http.Response
+		entitlement, apiResponse, err = t.client.CreateTestEntitlement(ctx, plan.ApplicationID.ValueString(), &discord.CreateTestEntitlement{
+			SKUID:     plan.SKUID.ValueString(),
+			OwnerID:   plan.OwnerID.ValueString(),
+			OwnerType: testEntitlementOwnerTypes[plan.OwnerType.ValueString()],
+		})
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Error creating test entitlement",
+				"Could not create test entitlement, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		if apiResponse.StatusCode != http.StatusCreated && apiResponse.StatusCode != http.StatusOK {
+			response.Diagnostics.AddError(
+				"Error creating test entitlement",
+				fmt.Sprintf("Could not create test entitlement, unexpected status code: %d", apiResponse.StatusCode),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromEntitlement(entitlement)
+
+	// Set state to fully populated data
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (t *testEntitlementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state testEntitlementResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed entitlement value from discord
+	entitlement, apiResponse, err := t.client.GetEntitlement(ctx, state.ApplicationID.ValueString(), state.EntitlementID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Test Entitlement",
+			"Could not read Discord Application Test Entitlement | ID: "+state.EntitlementID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+" | Error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode == http.StatusNotFound {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusOK {
+		response.Diagnostics.AddError(
+			"Error Reading Discord Application Test Entitlement",
+			"Could not read Discord Application Test Entitlement | ID: "+state.EntitlementID.ValueString()+" | Application ID: "+state.ApplicationID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+
+	// A consumed or deleted entitlement no longer grants the SKU, so it is treated as gone and granted again on apply
+	if entitlement.Deleted || entitlementConsumed(*entitlement) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromEntitlement(entitlement)
+
+	// Set refreshed state
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (t *testEntitlementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing to update in discord
+	var plan testEntitlementResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (t *testEntitlementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state testEntitlementResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponse, err := t.client.DeleteTestEntitlement(ctx, state.ApplicationID.ValueString(), state.EntitlementID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Application Test Entitlement",
+			"Could not delete test entitlement, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if apiResponse.StatusCode != http.StatusNoContent && apiResponse.StatusCode != http.StatusNotFound {
+		response.Diagnostics.AddError(
+			"Error Deleting Discord Application Test Entitlement",
+			"Could not delete Discord Application Test Entitlement ID "+state.EntitlementID.ValueString()+": "+apiResponse.Status,
+		)
+		return
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"time"
+)
+
+// defaultOperationTimeout bounds a create, read, update or delete when its timeout is not configured.
+const defaultOperationTimeout = 20 * time.Minute
+
+var operationVerbs = map[string]string{
+	"create": "Creating",
+	"read":   "Reading",
+	"update": "Updating",
+	"delete": "Deleting",
+}
+
+// addTimeoutError reports err as the operation timing out when the deadline of the operation context ctx was exceeded,
+// returning whether it did. The error itself is not inspected, as a request hitting the HTTP client timeout also wraps
+// context.DeadlineExceeded.
+func addTimeoutError(ctx context.Context, err error, resourceName, operation string, timeout time.Duration, diags *diag.Diagnostics) bool {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+
+	diags.AddError(
+		fmt.Sprintf("Timeout %s %s", operationVerbs[operation], resourceName),
+		fmt.Sprintf("The %s operation did not complete within its %s timeout. "+
+			"Increase timeouts.%s to allow more time, for example while discord is rate limiting requests", operation, timeout, operation),
+	)
+	return true
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MichaelFraser99/terraform-provider-discord-application/internal/discord"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAddTimeoutError(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	tests := []struct {
+		name          string
+		clientTimeout time.Duration
+		ctxTimeout    time.Duration
+		want          bool
+	}{
+		{name: "http client timeout", clientTimeout: 20 * time.Millisecond, ctxTimeout: time.Minute},
+		{name: "operation timeout", clientTimeout: time.Minute, ctxTimeout: 20 * time.Millisecond, want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpClient := server.Client()
+			httpClient.Timeout = test.clientTimeout
+			client := discord.NewClient(&discord.Config{Token: "token", BaseUrl: server.URL, HTTPClient: httpClient})
+
+			ctx, cancel := context.WithTimeout(context.Background(), test.ctxTimeout)
+			defer cancel()
+
+			_, _, err := client.GetCommand(ctx, "1", "2")
+			if err == nil {
+				t.Fatal("expected the request to fail")
+			}
+
+			var diags diag.Diagnostics
+			if got := addTimeoutError(ctx, err, "Discord Application Command", "read", test.ctxTimeout, &diags); got != test.want {
+				t.Errorf("expected %t, got %t (%s)", test.want, got, err)
+			}
+			if diags.HasError() != test.want {
+				t.Errorf("expected error %t, got %v", test.want, diags)
+			}
+		})
+	}
+}
+
+func TestAddTimeoutErrorWithoutError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	var diags diag.Diagnostics
+	if addTimeoutError(ctx, nil, "Discord Application Command", "read", 0, &diags) || diags.HasError() {
+		t.Errorf("expected no timeout error for a successful request, got %v", diags)
+	}
+}